@@ -2,6 +2,9 @@ package gramgo
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"iter"
 
 	"github.com/OhMyDitzzy/gramgo/types"
 )
@@ -9,20 +12,944 @@ import (
 // SendMessage https://core.telegram.org/bots/api#sendmessage
 func (b *GramGoBot) SendMessage(ctx context.Context, params *types.SendMessageParams) (*types.Message, error) {
 	msg := &types.Message{}
-	err := b.rawRequest(ctx, "sendMessage", params, &msg)
+	err := b.rawRequest(ctx, "sendMessage", params, msg)
 	return msg, err
 }
 
+// ForwardMessage https://core.telegram.org/bots/api#forwardmessage
+func (b *GramGoBot) ForwardMessage(ctx context.Context, params *types.ForwardMessageParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "forwardMessage", params, msg)
+	return msg, err
+}
+
+// ForwardMessages https://core.telegram.org/bots/api#forwardmessages
+func (b *GramGoBot) ForwardMessages(ctx context.Context, params *types.ForwardMessagesParams) ([]types.MessageID, error) {
+	var ids []types.MessageID
+	err := b.rawRequest(ctx, "forwardMessages", params, &ids)
+	return ids, err
+}
+
+// CopyMessage https://core.telegram.org/bots/api#copymessage
+func (b *GramGoBot) CopyMessage(ctx context.Context, params *types.CopyMessageParams) (*types.MessageID, error) {
+	id := &types.MessageID{}
+	err := b.rawRequest(ctx, "copyMessage", params, id)
+	return id, err
+}
+
+// CopyMessages https://core.telegram.org/bots/api#copymessages
+func (b *GramGoBot) CopyMessages(ctx context.Context, params *types.CopyMessagesParams) ([]types.MessageID, error) {
+	var ids []types.MessageID
+	err := b.rawRequest(ctx, "copyMessages", params, &ids)
+	return ids, err
+}
+
 // SendPhoto https://core.telegram.org/bots/api#SendPhoto
 func (b *GramGoBot) SendPhoto(ctx context.Context, params *types.SendPhotoParams) (*types.Message, error) {
 	msg := &types.Message{}
-	err := b.rawRequest(ctx, "sendPhoto", params, &msg)
+	err := b.rawRequest(ctx, "sendPhoto", params, msg)
+	return msg, err
+}
+
+// SendAudio https://core.telegram.org/bots/api#sendaudio
+func (b *GramGoBot) SendAudio(ctx context.Context, params *types.SendAudioParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendAudio", params, msg)
+	return msg, err
+}
+
+// SendDocument https://core.telegram.org/bots/api#senddocument
+func (b *GramGoBot) SendDocument(ctx context.Context, params *types.SendDocumentParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendDocument", params, msg)
+	return msg, err
+}
+
+// SendVideo https://core.telegram.org/bots/api#sendvideo
+func (b *GramGoBot) SendVideo(ctx context.Context, params *types.SendVideoParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendVideo", params, msg)
+	return msg, err
+}
+
+// SendAnimation https://core.telegram.org/bots/api#sendanimation
+func (b *GramGoBot) SendAnimation(ctx context.Context, params *types.SendAnimationParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendAnimation", params, msg)
+	return msg, err
+}
+
+// SendVoice https://core.telegram.org/bots/api#sendvoice
+func (b *GramGoBot) SendVoice(ctx context.Context, params *types.SendVoiceParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendVoice", params, msg)
+	return msg, err
+}
+
+// SendVideoNote https://core.telegram.org/bots/api#sendvideonote
+func (b *GramGoBot) SendVideoNote(ctx context.Context, params *types.SendVideoNoteParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendVideoNote", params, msg)
+	return msg, err
+}
+
+// SendPaidMedia https://core.telegram.org/bots/api#sendpaidmedia
+func (b *GramGoBot) SendPaidMedia(ctx context.Context, params *types.SendPaidMediaParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendPaidMedia", params, msg)
+	return msg, err
+}
+
+// SendMediaGroup https://core.telegram.org/bots/api#sendmediagroup
+func (b *GramGoBot) SendMediaGroup(ctx context.Context, params *types.SendMediaGroupParams) ([]types.Message, error) {
+	var msgs []types.Message
+	err := b.rawRequest(ctx, "sendMediaGroup", params, &msgs)
+	return msgs, err
+}
+
+// SendLocation https://core.telegram.org/bots/api#sendlocation
+func (b *GramGoBot) SendLocation(ctx context.Context, params *types.SendLocationParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendLocation", params, msg)
+	return msg, err
+}
+
+// SendVenue https://core.telegram.org/bots/api#sendvenue
+func (b *GramGoBot) SendVenue(ctx context.Context, params *types.SendVenueParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendVenue", params, msg)
+	return msg, err
+}
+
+// SendContact https://core.telegram.org/bots/api#sendcontact
+func (b *GramGoBot) SendContact(ctx context.Context, params *types.SendContactParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendContact", params, msg)
+	return msg, err
+}
+
+// SendPoll https://core.telegram.org/bots/api#sendpoll
+func (b *GramGoBot) SendPoll(ctx context.Context, params *types.SendPollParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendPoll", params, msg)
+	return msg, err
+}
+
+// SendChecklist https://core.telegram.org/bots/api#sendchecklist
+func (b *GramGoBot) SendChecklist(ctx context.Context, params *types.SendChecklistParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendChecklist", params, msg)
 	return msg, err
 }
 
 // https://core.telegram.org/bots/api#senddice
 func (b *GramGoBot) SendDice(ctx context.Context, params *types.SendDiceParams) (*types.Message, error) {
 	msg := &types.Message{}
-	err := b.rawRequest(ctx, "sendDice", params, &msg)
+	err := b.rawRequest(ctx, "sendDice", params, msg)
+	return msg, err
+}
+
+// EditMessageText https://core.telegram.org/bots/api#editmessagetext
+func (b *GramGoBot) EditMessageText(ctx context.Context, params *types.EditMessageTextParams) (*types.MessageOrTrue, error) {
+	res := &types.MessageOrTrue{}
+	err := b.rawRequest(ctx, "editMessageText", params, res)
+	return res, err
+}
+
+// EditMessageCaption https://core.telegram.org/bots/api#editmessagecaption
+func (b *GramGoBot) EditMessageCaption(ctx context.Context, params *types.EditMessageCaptionParams) (*types.MessageOrTrue, error) {
+	res := &types.MessageOrTrue{}
+	err := b.rawRequest(ctx, "editMessageCaption", params, res)
+	return res, err
+}
+
+// EditMessageMedia https://core.telegram.org/bots/api#editmessagemedia
+func (b *GramGoBot) EditMessageMedia(ctx context.Context, params *types.EditMessageMediaParams) (*types.MessageOrTrue, error) {
+	res := &types.MessageOrTrue{}
+	err := b.rawRequest(ctx, "editMessageMedia", params, res)
+	return res, err
+}
+
+// EditMessageLiveLocation https://core.telegram.org/bots/api#editmessagelivelocation
+func (b *GramGoBot) EditMessageLiveLocation(ctx context.Context, params *types.EditMessageLiveLocationParams) (*types.MessageOrTrue, error) {
+	res := &types.MessageOrTrue{}
+	err := b.rawRequest(ctx, "editMessageLiveLocation", params, res)
+	return res, err
+}
+
+// StopMessageLiveLocation https://core.telegram.org/bots/api#stopmessagelivelocation
+func (b *GramGoBot) StopMessageLiveLocation(ctx context.Context, params *types.StopMessageLiveLocationParams) (*types.MessageOrTrue, error) {
+	res := &types.MessageOrTrue{}
+	err := b.rawRequest(ctx, "stopMessageLiveLocation", params, res)
+	return res, err
+}
+
+// EditMessageChecklist https://core.telegram.org/bots/api#editmessagechecklist
+func (b *GramGoBot) EditMessageChecklist(ctx context.Context, params *types.EditMessageChecklistParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "editMessageChecklist", params, msg)
+	return msg, err
+}
+
+// EditMessageReplyMarkup https://core.telegram.org/bots/api#editmessagereplymarkup
+func (b *GramGoBot) EditMessageReplyMarkup(ctx context.Context, params *types.EditMessageReplyMarkupParams) (*types.MessageOrTrue, error) {
+	res := &types.MessageOrTrue{}
+	err := b.rawRequest(ctx, "editMessageReplyMarkup", params, res)
+	return res, err
+}
+
+// StopPoll https://core.telegram.org/bots/api#stoppoll
+func (b *GramGoBot) StopPoll(ctx context.Context, params *types.StopPollParams) (*types.Poll, error) {
+	poll := &types.Poll{}
+	err := b.rawRequest(ctx, "stopPoll", params, poll)
+	return poll, err
+}
+
+// BanChatMember https://core.telegram.org/bots/api#banchatmember
+func (b *GramGoBot) BanChatMember(ctx context.Context, params *types.BanChatMemberParams) error {
+	var result bool
+	return b.rawRequest(ctx, "banChatMember", params, &result)
+}
+
+// UnbanChatMember https://core.telegram.org/bots/api#unbanchatmember
+func (b *GramGoBot) UnbanChatMember(ctx context.Context, params *types.UnbanChatMemberParams) error {
+	var result bool
+	return b.rawRequest(ctx, "unbanChatMember", params, &result)
+}
+
+// RestrictChatMember https://core.telegram.org/bots/api#restrictchatmember
+func (b *GramGoBot) RestrictChatMember(ctx context.Context, params *types.RestrictChatMemberParams) error {
+	var result bool
+	return b.rawRequest(ctx, "restrictChatMember", params, &result)
+}
+
+// PromoteChatMember https://core.telegram.org/bots/api#promotechatmember
+func (b *GramGoBot) PromoteChatMember(ctx context.Context, params *types.PromoteChatMemberParams) error {
+	var result bool
+	return b.rawRequest(ctx, "promoteChatMember", params, &result)
+}
+
+// SetChatAdministratorCustomTitle https://core.telegram.org/bots/api#setchatadministratorcustomtitle
+func (b *GramGoBot) SetChatAdministratorCustomTitle(ctx context.Context, params *types.SetChatAdministratorCustomTitleParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setChatAdministratorCustomTitle", params, &result)
+}
+
+// BanChatSenderChat https://core.telegram.org/bots/api#banchatsenderchat
+func (b *GramGoBot) BanChatSenderChat(ctx context.Context, params *types.BanChatSenderChatParams) error {
+	var result bool
+	return b.rawRequest(ctx, "banChatSenderChat", params, &result)
+}
+
+// UnbanChatSenderChat https://core.telegram.org/bots/api#unbanchatsenderchat
+func (b *GramGoBot) UnbanChatSenderChat(ctx context.Context, params *types.UnbanChatSenderChatParams) error {
+	var result bool
+	return b.rawRequest(ctx, "unbanChatSenderChat", params, &result)
+}
+
+// SetChatPermissions https://core.telegram.org/bots/api#setchatpermissions
+func (b *GramGoBot) SetChatPermissions(ctx context.Context, params *types.SetChatPermissionsParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setChatPermissions", params, &result)
+}
+
+// ExportChatInviteLink https://core.telegram.org/bots/api#exportchatinvitelink
+func (b *GramGoBot) ExportChatInviteLink(ctx context.Context, params *types.ExportChatInviteLinkParams) (string, error) {
+	var link string
+	err := b.rawRequest(ctx, "exportChatInviteLink", params, &link)
+	return link, err
+}
+
+// CreateChatInviteLink https://core.telegram.org/bots/api#createchatinvitelink
+func (b *GramGoBot) CreateChatInviteLink(ctx context.Context, params *types.CreateChatInviteLinkParams) (*types.ChatInviteLink, error) {
+	link := &types.ChatInviteLink{}
+	err := b.rawRequest(ctx, "createChatInviteLink", params, link)
+	return link, err
+}
+
+// EditChatInviteLink https://core.telegram.org/bots/api#editchatinvitelink
+func (b *GramGoBot) EditChatInviteLink(ctx context.Context, params *types.EditChatInviteLinkParams) (*types.ChatInviteLink, error) {
+	link := &types.ChatInviteLink{}
+	err := b.rawRequest(ctx, "editChatInviteLink", params, link)
+	return link, err
+}
+
+// CreateChatSubscriptionInviteLink https://core.telegram.org/bots/api#createchatsubscriptioninvitelink
+func (b *GramGoBot) CreateChatSubscriptionInviteLink(ctx context.Context, params *types.CreateChatSubscriptionInviteLinkParams) (*types.ChatInviteLink, error) {
+	link := &types.ChatInviteLink{}
+	err := b.rawRequest(ctx, "createChatSubscriptionInviteLink", params, link)
+	return link, err
+}
+
+// EditChatSubscriptionInviteLink https://core.telegram.org/bots/api#editchatsubscriptioninvitelink
+func (b *GramGoBot) EditChatSubscriptionInviteLink(ctx context.Context, params *types.EditChatSubscriptionInviteLinkParams) (*types.ChatInviteLink, error) {
+	link := &types.ChatInviteLink{}
+	err := b.rawRequest(ctx, "editChatSubscriptionInviteLink", params, link)
+	return link, err
+}
+
+// RevokeChatInviteLink https://core.telegram.org/bots/api#revokechatinvitelink
+func (b *GramGoBot) RevokeChatInviteLink(ctx context.Context, params *types.RevokeChatInviteLinkParams) (*types.ChatInviteLink, error) {
+	link := &types.ChatInviteLink{}
+	err := b.rawRequest(ctx, "revokeChatInviteLink", params, link)
+	return link, err
+}
+
+// ApproveChatJoinRequest https://core.telegram.org/bots/api#approvechatjoinrequest
+func (b *GramGoBot) ApproveChatJoinRequest(ctx context.Context, params *types.ApproveChatJoinRequestParams) error {
+	var result bool
+	return b.rawRequest(ctx, "approveChatJoinRequest", params, &result)
+}
+
+// DeclineChatJoinRequest https://core.telegram.org/bots/api#declinechatjoinrequest
+func (b *GramGoBot) DeclineChatJoinRequest(ctx context.Context, params *types.DeclineChatJoinRequestParams) error {
+	var result bool
+	return b.rawRequest(ctx, "declineChatJoinRequest", params, &result)
+}
+
+// GetChat https://core.telegram.org/bots/api#getchat
+func (b *GramGoBot) GetChat(ctx context.Context, params *types.GetChatParams) (*types.ChatFullInfo, error) {
+	chat := &types.ChatFullInfo{}
+	err := b.rawRequest(ctx, "getChat", params, chat)
+	return chat, err
+}
+
+// GetChatAdministrators https://core.telegram.org/bots/api#getchatadministrators
+func (b *GramGoBot) GetChatAdministrators(ctx context.Context, params *types.GetChatAdministratorsParams) ([]types.ChatMember, error) {
+	var members []types.ChatMember
+	err := b.rawRequest(ctx, "getChatAdministrators", params, &members)
+	return members, err
+}
+
+// GetChatMember https://core.telegram.org/bots/api#getchatmember
+func (b *GramGoBot) GetChatMember(ctx context.Context, params *types.GetChatMemberParams) (*types.ChatMember, error) {
+	member := &types.ChatMember{}
+	err := b.rawRequest(ctx, "getChatMember", params, member)
+	return member, err
+}
+
+// GetChatMemberCount https://core.telegram.org/bots/api#getchatmembercount
+func (b *GramGoBot) GetChatMemberCount(ctx context.Context, params *types.GetChatMemberCountParams) (int, error) {
+	var count int
+	err := b.rawRequest(ctx, "getChatMemberCount", params, &count)
+	return count, err
+}
+
+// LeaveChat https://core.telegram.org/bots/api#leavechat
+func (b *GramGoBot) LeaveChat(ctx context.Context, params *types.LeaveChatParams) error {
+	var result bool
+	return b.rawRequest(ctx, "leaveChat", params, &result)
+}
+
+// GetUserProfilePhotos https://core.telegram.org/bots/api#getuserprofilephotos
+func (b *GramGoBot) GetUserProfilePhotos(ctx context.Context, params *types.GetUserProfilePhotosParams) (*types.UserProfilePhotos, error) {
+	photos := &types.UserProfilePhotos{}
+	err := b.rawRequest(ctx, "getUserProfilePhotos", params, photos)
+	return photos, err
+}
+
+// GetUserChatBoosts https://core.telegram.org/bots/api#getuserchatboosts
+func (b *GramGoBot) GetUserChatBoosts(ctx context.Context, params *types.GetUserChatBoostsParams) (*types.UserChatBoosts, error) {
+	boosts := &types.UserChatBoosts{}
+	err := b.rawRequest(ctx, "getUserChatBoosts", params, boosts)
+	return boosts, err
+}
+
+// GetForumTopicIconStickers https://core.telegram.org/bots/api#getforumtopiciconstickers
+func (b *GramGoBot) GetForumTopicIconStickers(ctx context.Context) ([]types.Sticker, error) {
+	var stickers []types.Sticker
+	err := b.rawRequest(ctx, "getForumTopicIconStickers", nil, &stickers)
+	return stickers, err
+}
+
+// CreateForumTopic https://core.telegram.org/bots/api#createforumtopic
+func (b *GramGoBot) CreateForumTopic(ctx context.Context, params *types.CreateForumTopicParams) (*types.ForumTopic, error) {
+	topic := &types.ForumTopic{}
+	err := b.rawRequest(ctx, "createForumTopic", params, topic)
+	return topic, err
+}
+
+// EditForumTopic https://core.telegram.org/bots/api#editforumtopic
+func (b *GramGoBot) EditForumTopic(ctx context.Context, params *types.EditForumTopicParams) error {
+	var result bool
+	return b.rawRequest(ctx, "editForumTopic", params, &result)
+}
+
+// CloseForumTopic https://core.telegram.org/bots/api#closeforumtopic
+func (b *GramGoBot) CloseForumTopic(ctx context.Context, params *types.CloseForumTopicParams) error {
+	var result bool
+	return b.rawRequest(ctx, "closeForumTopic", params, &result)
+}
+
+// ReopenForumTopic https://core.telegram.org/bots/api#reopenforumtopic
+func (b *GramGoBot) ReopenForumTopic(ctx context.Context, params *types.ReopenForumTopicParams) error {
+	var result bool
+	return b.rawRequest(ctx, "reopenForumTopic", params, &result)
+}
+
+// DeleteForumTopic https://core.telegram.org/bots/api#deleteforumtopic
+func (b *GramGoBot) DeleteForumTopic(ctx context.Context, params *types.DeleteForumTopicParams) error {
+	var result bool
+	return b.rawRequest(ctx, "deleteForumTopic", params, &result)
+}
+
+// UnpinAllForumTopicMessages https://core.telegram.org/bots/api#unpinallforumtopicmessages
+func (b *GramGoBot) UnpinAllForumTopicMessages(ctx context.Context, params *types.UnpinAllForumTopicMessagesParams) error {
+	var result bool
+	return b.rawRequest(ctx, "unpinAllForumTopicMessages", params, &result)
+}
+
+// EditGeneralForumTopic https://core.telegram.org/bots/api#editgeneralforumtopic
+func (b *GramGoBot) EditGeneralForumTopic(ctx context.Context, params *types.EditGeneralForumTopicParams) error {
+	var result bool
+	return b.rawRequest(ctx, "editGeneralForumTopic", params, &result)
+}
+
+// CloseGeneralForumTopic https://core.telegram.org/bots/api#closegeneralforumtopic
+func (b *GramGoBot) CloseGeneralForumTopic(ctx context.Context, params *types.CloseGeneralForumTopicParams) error {
+	var result bool
+	return b.rawRequest(ctx, "closeGeneralForumTopic", params, &result)
+}
+
+// ReopenGeneralForumTopic https://core.telegram.org/bots/api#reopengeneralforumtopic
+func (b *GramGoBot) ReopenGeneralForumTopic(ctx context.Context, params *types.ReopenGeneralForumTopicParams) error {
+	var result bool
+	return b.rawRequest(ctx, "reopenGeneralForumTopic", params, &result)
+}
+
+// HideGeneralForumTopic https://core.telegram.org/bots/api#hidegeneralforumtopic
+func (b *GramGoBot) HideGeneralForumTopic(ctx context.Context, params *types.HideGeneralForumTopicParams) error {
+	var result bool
+	return b.rawRequest(ctx, "hideGeneralForumTopic", params, &result)
+}
+
+// UnhideGeneralForumTopic https://core.telegram.org/bots/api#unhidegeneralforumtopic
+func (b *GramGoBot) UnhideGeneralForumTopic(ctx context.Context, params *types.UnhideGeneralForumTopicParams) error {
+	var result bool
+	return b.rawRequest(ctx, "unhideGeneralForumTopic", params, &result)
+}
+
+// UnpinAllGeneralForumTopicMessages https://core.telegram.org/bots/api#unpinallgeneralforumtopicmessages
+func (b *GramGoBot) UnpinAllGeneralForumTopicMessages(ctx context.Context, params *types.UnpinAllGeneralForumTopicMessagesParams) error {
+	var result bool
+	return b.rawRequest(ctx, "unpinAllGeneralForumTopicMessages", params, &result)
+}
+
+// PinChatMessage https://core.telegram.org/bots/api#pinchatmessage
+func (b *GramGoBot) PinChatMessage(ctx context.Context, params *types.PinChatMessageParams) error {
+	var result bool
+	return b.rawRequest(ctx, "pinChatMessage", params, &result)
+}
+
+// UnpinChatMessage https://core.telegram.org/bots/api#unpinchatmessage
+func (b *GramGoBot) UnpinChatMessage(ctx context.Context, params *types.UnpinChatMessageParams) error {
+	var result bool
+	return b.rawRequest(ctx, "unpinChatMessage", params, &result)
+}
+
+// UnpinAllChatMessages https://core.telegram.org/bots/api#unpinallchatmessages
+func (b *GramGoBot) UnpinAllChatMessages(ctx context.Context, params *types.UnpinAllChatMessagesParams) error {
+	var result bool
+	return b.rawRequest(ctx, "unpinAllChatMessages", params, &result)
+}
+
+// DeleteMessage https://core.telegram.org/bots/api#deletemessage
+func (b *GramGoBot) DeleteMessage(ctx context.Context, params *types.DeleteMessageParams) error {
+	var result bool
+	return b.rawRequest(ctx, "deleteMessage", params, &result)
+}
+
+// maxDeleteMessageIDs is the number of message IDs deleteMessages accepts
+// in a single call.
+const maxDeleteMessageIDs = 100
+
+// DeleteMessages https://core.telegram.org/bots/api#deletemessages
+//
+// More than 100 message IDs are split across several calls. Every chunk is
+// attempted and the errors of the failed ones are joined.
+func (b *GramGoBot) DeleteMessages(ctx context.Context, params *types.DeleteMessagesParams) error {
+	if len(params.MessageIDs) <= maxDeleteMessageIDs {
+		var result bool
+		return b.rawRequest(ctx, "deleteMessages", params, &result)
+	}
+
+	var errs []error
+	for start := 0; start < len(params.MessageIDs); start += maxDeleteMessageIDs {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		end := min(start+maxDeleteMessageIDs, len(params.MessageIDs))
+		chunk := &types.DeleteMessagesParams{
+			ChatID:     params.ChatID,
+			MessageIDs: params.MessageIDs[start:end],
+		}
+
+		var result bool
+		if err := b.rawRequest(ctx, "deleteMessages", chunk, &result); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete message ids [%d:%d]: %w", start, end, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SetMyCommands https://core.telegram.org/bots/api#setmycommands
+func (b *GramGoBot) SetMyCommands(ctx context.Context, params *types.SetMyCommandsParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setMyCommands", params, &result)
+}
+
+// DeleteMyCommands https://core.telegram.org/bots/api#deletemycommands
+func (b *GramGoBot) DeleteMyCommands(ctx context.Context, params *types.DeleteMyCommandsParams) error {
+	var result bool
+	return b.rawRequest(ctx, "deleteMyCommands", params, &result)
+}
+
+// GetMyCommands https://core.telegram.org/bots/api#getmycommands
+func (b *GramGoBot) GetMyCommands(ctx context.Context, params *types.GetMyCommandsParams) ([]types.BotCommand, error) {
+	var commands []types.BotCommand
+	err := b.rawRequest(ctx, "getMyCommands", params, &commands)
+	return commands, err
+}
+
+// SetMyName https://core.telegram.org/bots/api#setmyname
+func (b *GramGoBot) SetMyName(ctx context.Context, params *types.SetMyNameParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setMyName", params, &result)
+}
+
+// GetMyName https://core.telegram.org/bots/api#getmyname
+func (b *GramGoBot) GetMyName(ctx context.Context, params *types.GetMyNameParams) (*types.BotName, error) {
+	name := &types.BotName{}
+	err := b.rawRequest(ctx, "getMyName", params, name)
+	return name, err
+}
+
+// SetMyDescription https://core.telegram.org/bots/api#setmydescription
+func (b *GramGoBot) SetMyDescription(ctx context.Context, params *types.SetMyDescriptionParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setMyDescription", params, &result)
+}
+
+// GetMyDescription https://core.telegram.org/bots/api#getmydescription
+func (b *GramGoBot) GetMyDescription(ctx context.Context, params *types.GetMyDescriptionParams) (*types.BotDescription, error) {
+	description := &types.BotDescription{}
+	err := b.rawRequest(ctx, "getMyDescription", params, description)
+	return description, err
+}
+
+// SetMyShortDescription https://core.telegram.org/bots/api#setmyshortdescription
+func (b *GramGoBot) SetMyShortDescription(ctx context.Context, params *types.SetMyShortDescriptionParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setMyShortDescription", params, &result)
+}
+
+// GetMyShortDescription https://core.telegram.org/bots/api#getmyshortdescription
+func (b *GramGoBot) GetMyShortDescription(ctx context.Context, params *types.GetMyShortDescriptionParams) (*types.BotShortDescription, error) {
+	description := &types.BotShortDescription{}
+	err := b.rawRequest(ctx, "getMyShortDescription", params, description)
+	return description, err
+}
+
+// SetChatMenuButton https://core.telegram.org/bots/api#setchatmenubutton
+func (b *GramGoBot) SetChatMenuButton(ctx context.Context, params *types.SetChatMenuButtonParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setChatMenuButton", params, &result)
+}
+
+// GetChatMenuButton https://core.telegram.org/bots/api#getchatmenubutton
+func (b *GramGoBot) GetChatMenuButton(ctx context.Context, params *types.GetChatMenuButtonParams) (*types.MenuButton, error) {
+	button := &types.MenuButton{}
+	err := b.rawRequest(ctx, "getChatMenuButton", params, button)
+	return button, err
+}
+
+// SetMyDefaultAdministratorRights https://core.telegram.org/bots/api#setmydefaultadministratorrights
+func (b *GramGoBot) SetMyDefaultAdministratorRights(ctx context.Context, params *types.SetMyDefaultAdministratorRightsParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setMyDefaultAdministratorRights", params, &result)
+}
+
+// GetMyDefaultAdministratorRights https://core.telegram.org/bots/api#getmydefaultadministratorrights
+func (b *GramGoBot) GetMyDefaultAdministratorRights(ctx context.Context, params *types.GetMyDefaultAdministratorRightsParams) (*types.ChatAdministratorRights, error) {
+	rights := &types.ChatAdministratorRights{}
+	err := b.rawRequest(ctx, "getMyDefaultAdministratorRights", params, rights)
+	return rights, err
+}
+
+// SendSticker https://core.telegram.org/bots/api#sendsticker
+func (b *GramGoBot) SendSticker(ctx context.Context, params *types.SendStickerParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendSticker", params, msg)
+	return msg, err
+}
+
+// GetStickerSet https://core.telegram.org/bots/api#getstickerset
+func (b *GramGoBot) GetStickerSet(ctx context.Context, params *types.GetStickerSetParams) (*types.StickerSet, error) {
+	set := &types.StickerSet{}
+	err := b.rawRequest(ctx, "getStickerSet", params, set)
+	return set, err
+}
+
+// GetCustomEmojiStickers https://core.telegram.org/bots/api#getcustomemojistickers
+func (b *GramGoBot) GetCustomEmojiStickers(ctx context.Context, params *types.GetCustomEmojiStickersParams) ([]types.Sticker, error) {
+	var stickers []types.Sticker
+	err := b.rawRequest(ctx, "getCustomEmojiStickers", params, &stickers)
+	return stickers, err
+}
+
+// UploadStickerFile https://core.telegram.org/bots/api#uploadstickerfile
+func (b *GramGoBot) UploadStickerFile(ctx context.Context, params *types.UploadStickerFileParams) (*types.File, error) {
+	file := &types.File{}
+	err := b.rawRequest(ctx, "uploadStickerFile", params, file)
+	return file, err
+}
+
+// CreateNewStickerSet https://core.telegram.org/bots/api#createnewstickerset
+func (b *GramGoBot) CreateNewStickerSet(ctx context.Context, params *types.CreateNewStickerSetParams) error {
+	var result bool
+	return b.rawRequest(ctx, "createNewStickerSet", params, &result)
+}
+
+// AddStickerToSet https://core.telegram.org/bots/api#addstickertoset
+func (b *GramGoBot) AddStickerToSet(ctx context.Context, params *types.AddStickerToSetParams) error {
+	var result bool
+	return b.rawRequest(ctx, "addStickerToSet", params, &result)
+}
+
+// SetStickerPositionInSet https://core.telegram.org/bots/api#setstickerpositioninset
+func (b *GramGoBot) SetStickerPositionInSet(ctx context.Context, params *types.SetStickerPositionInSetParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setStickerPositionInSet", params, &result)
+}
+
+// DeleteStickerFromSet https://core.telegram.org/bots/api#deletestickerfromset
+func (b *GramGoBot) DeleteStickerFromSet(ctx context.Context, params *types.DeleteStickerFromSetParams) error {
+	var result bool
+	return b.rawRequest(ctx, "deleteStickerFromSet", params, &result)
+}
+
+// ReplaceStickerInSet https://core.telegram.org/bots/api#replacestickerinset
+func (b *GramGoBot) ReplaceStickerInSet(ctx context.Context, params *types.ReplaceStickerInSetParams) error {
+	var result bool
+	return b.rawRequest(ctx, "replaceStickerInSet", params, &result)
+}
+
+// SetStickerEmojiList https://core.telegram.org/bots/api#setstickeremojilist
+func (b *GramGoBot) SetStickerEmojiList(ctx context.Context, params *types.SetStickerEmojiListParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setStickerEmojiList", params, &result)
+}
+
+// SetStickerKeywords https://core.telegram.org/bots/api#setstickerkeywords
+func (b *GramGoBot) SetStickerKeywords(ctx context.Context, params *types.SetStickerKeywordsParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setStickerKeywords", params, &result)
+}
+
+// SetStickerMaskPosition https://core.telegram.org/bots/api#setstickermaskposition
+func (b *GramGoBot) SetStickerMaskPosition(ctx context.Context, params *types.SetStickerMaskPositionParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setStickerMaskPosition", params, &result)
+}
+
+// SetStickerSetTitle https://core.telegram.org/bots/api#setstickersettitle
+func (b *GramGoBot) SetStickerSetTitle(ctx context.Context, params *types.SetStickerSetTitleParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setStickerSetTitle", params, &result)
+}
+
+// SetStickerSetThumbnail https://core.telegram.org/bots/api#setstickersetthumbnail
+func (b *GramGoBot) SetStickerSetThumbnail(ctx context.Context, params *types.SetStickerSetThumbnailParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setStickerSetThumbnail", params, &result)
+}
+
+// SetCustomEmojiStickerSetThumbnail https://core.telegram.org/bots/api#setcustomemojistickersetthumbnail
+func (b *GramGoBot) SetCustomEmojiStickerSetThumbnail(ctx context.Context, params *types.SetCustomEmojiStickerSetThumbnailParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setCustomEmojiStickerSetThumbnail", params, &result)
+}
+
+// DeleteStickerSet https://core.telegram.org/bots/api#deletestickerset
+func (b *GramGoBot) DeleteStickerSet(ctx context.Context, params *types.DeleteStickerSetParams) error {
+	var result bool
+	return b.rawRequest(ctx, "deleteStickerSet", params, &result)
+}
+
+// AnswerInlineQuery https://core.telegram.org/bots/api#answerinlinequery
+func (b *GramGoBot) AnswerInlineQuery(ctx context.Context, params *types.AnswerInlineQueryParams) error {
+	var result bool
+	return b.rawRequest(ctx, "answerInlineQuery", params, &result)
+}
+
+// AnswerWebAppQuery https://core.telegram.org/bots/api#answerwebappquery
+func (b *GramGoBot) AnswerWebAppQuery(ctx context.Context, params *types.AnswerWebAppQueryParams) (*types.SentWebAppMessage, error) {
+	sent := &types.SentWebAppMessage{}
+	err := b.rawRequest(ctx, "answerWebAppQuery", params, sent)
+	return sent, err
+}
+
+// SavePreparedInlineMessage https://core.telegram.org/bots/api#savepreparedinlinemessage
+func (b *GramGoBot) SavePreparedInlineMessage(ctx context.Context, params *types.SavePreparedInlineMessageParams) (*types.PreparedInlineMessage, error) {
+	prepared := &types.PreparedInlineMessage{}
+	err := b.rawRequest(ctx, "savePreparedInlineMessage", params, prepared)
+	return prepared, err
+}
+
+// SendInvoice https://core.telegram.org/bots/api#sendinvoice
+func (b *GramGoBot) SendInvoice(ctx context.Context, params *types.SendInvoiceParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendInvoice", params, msg)
 	return msg, err
 }
+
+// CreateInvoiceLink https://core.telegram.org/bots/api#createinvoicelink
+func (b *GramGoBot) CreateInvoiceLink(ctx context.Context, params *types.CreateInvoiceLinkParams) (string, error) {
+	var link string
+	err := b.rawRequest(ctx, "createInvoiceLink", params, &link)
+	return link, err
+}
+
+// AnswerShippingQuery https://core.telegram.org/bots/api#answershippingquery
+func (b *GramGoBot) AnswerShippingQuery(ctx context.Context, params *types.AnswerShippingQueryParams) error {
+	var result bool
+	return b.rawRequest(ctx, "answerShippingQuery", params, &result)
+}
+
+// AnswerPreCheckoutQuery https://core.telegram.org/bots/api#answerprecheckoutquery
+func (b *GramGoBot) AnswerPreCheckoutQuery(ctx context.Context, params *types.AnswerPreCheckoutQueryParams) error {
+	var result bool
+	return b.rawRequest(ctx, "answerPreCheckoutQuery", params, &result)
+}
+
+// GetMyStarBalance https://core.telegram.org/bots/api#getmystarbalance
+func (b *GramGoBot) GetMyStarBalance(ctx context.Context) (*types.StarAmount, error) {
+	balance := &types.StarAmount{}
+	err := b.rawRequest(ctx, "getMyStarBalance", nil, balance)
+	return balance, err
+}
+
+// GetStarTransactions https://core.telegram.org/bots/api#getstartransactions
+func (b *GramGoBot) GetStarTransactions(ctx context.Context, params *types.GetStarTransactionsParams) (*types.StarTransactions, error) {
+	transactions := &types.StarTransactions{}
+	err := b.rawRequest(ctx, "getStarTransactions", params, transactions)
+	return transactions, err
+}
+
+// RefundStarPayment https://core.telegram.org/bots/api#refundstarpayment
+func (b *GramGoBot) RefundStarPayment(ctx context.Context, params *types.RefundStarPaymentParams) error {
+	var result bool
+	return b.rawRequest(ctx, "refundStarPayment", params, &result)
+}
+
+// EditUserStarSubscription https://core.telegram.org/bots/api#edituserstarsubscription
+func (b *GramGoBot) EditUserStarSubscription(ctx context.Context, params *types.EditUserStarSubscriptionParams) error {
+	var result bool
+	return b.rawRequest(ctx, "editUserStarSubscription", params, &result)
+}
+
+// SetPassportDataErrors https://core.telegram.org/bots/api#setpassportdataerrors
+func (b *GramGoBot) SetPassportDataErrors(ctx context.Context, params *types.SetPassportDataErrorsParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setPassportDataErrors", params, &result)
+}
+
+// SendGame https://core.telegram.org/bots/api#sendgame
+func (b *GramGoBot) SendGame(ctx context.Context, params *types.SendGameParams) (*types.Message, error) {
+	msg := &types.Message{}
+	err := b.rawRequest(ctx, "sendGame", params, msg)
+	return msg, err
+}
+
+// SetGameScore https://core.telegram.org/bots/api#setgamescore
+func (b *GramGoBot) SetGameScore(ctx context.Context, params *types.SetGameScoreParams) (*types.MessageOrTrue, error) {
+	res := &types.MessageOrTrue{}
+	err := b.rawRequest(ctx, "setGameScore", params, res)
+	return res, err
+}
+
+// GetGameHighScores https://core.telegram.org/bots/api#getgamehighscores
+func (b *GramGoBot) GetGameHighScores(ctx context.Context, params *types.GetGameHighScoresParams) ([]types.GameHighScore, error) {
+	var scores []types.GameHighScore
+	err := b.rawRequest(ctx, "getGameHighScores", params, &scores)
+	return scores, err
+}
+
+// GetBusinessConnection https://core.telegram.org/bots/api#getbusinessconnection
+func (b *GramGoBot) GetBusinessConnection(ctx context.Context, params *types.GetBusinessConnectionParams) (*types.BusinessConnection, error) {
+	connection := &types.BusinessConnection{}
+	err := b.rawRequest(ctx, "getBusinessConnection", params, connection)
+	return connection, err
+}
+
+// ReadBusinessMessage https://core.telegram.org/bots/api#readbusinessmessage
+func (b *GramGoBot) ReadBusinessMessage(ctx context.Context, params *types.ReadBusinessMessageParams) error {
+	var result bool
+	return b.rawRequest(ctx, "readBusinessMessage", params, &result)
+}
+
+// DeleteBusinessMessages https://core.telegram.org/bots/api#deletebusinessmessages
+func (b *GramGoBot) DeleteBusinessMessages(ctx context.Context, params *types.DeleteBusinessMessagesParams) error {
+	var result bool
+	return b.rawRequest(ctx, "deleteBusinessMessages", params, &result)
+}
+
+// SetBusinessAccountName https://core.telegram.org/bots/api#setbusinessaccountname
+func (b *GramGoBot) SetBusinessAccountName(ctx context.Context, params *types.SetBusinessAccountNameParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setBusinessAccountName", params, &result)
+}
+
+// SetBusinessAccountUsername https://core.telegram.org/bots/api#setbusinessaccountusername
+func (b *GramGoBot) SetBusinessAccountUsername(ctx context.Context, params *types.SetBusinessAccountUsernameParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setBusinessAccountUsername", params, &result)
+}
+
+// SetBusinessAccountBio https://core.telegram.org/bots/api#setbusinessaccountbio
+func (b *GramGoBot) SetBusinessAccountBio(ctx context.Context, params *types.SetBusinessAccountBioParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setBusinessAccountBio", params, &result)
+}
+
+// SetBusinessAccountProfilePhoto https://core.telegram.org/bots/api#setbusinessaccountprofilephoto
+func (b *GramGoBot) SetBusinessAccountProfilePhoto(ctx context.Context, params *types.SetBusinessAccountProfilePhotoParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setBusinessAccountProfilePhoto", params, &result)
+}
+
+// RemoveBusinessAccountProfilePhoto https://core.telegram.org/bots/api#removebusinessaccountprofilephoto
+func (b *GramGoBot) RemoveBusinessAccountProfilePhoto(ctx context.Context, params *types.RemoveBusinessAccountProfilePhotoParams) error {
+	var result bool
+	return b.rawRequest(ctx, "removeBusinessAccountProfilePhoto", params, &result)
+}
+
+// SetBusinessAccountGiftSettings https://core.telegram.org/bots/api#setbusinessaccountgiftsettings
+func (b *GramGoBot) SetBusinessAccountGiftSettings(ctx context.Context, params *types.SetBusinessAccountGiftSettingsParams) error {
+	var result bool
+	return b.rawRequest(ctx, "setBusinessAccountGiftSettings", params, &result)
+}
+
+// GetBusinessAccountStarBalance https://core.telegram.org/bots/api#getbusinessaccountstarbalance
+func (b *GramGoBot) GetBusinessAccountStarBalance(ctx context.Context, params *types.GetBusinessAccountStarBalanceParams) (*types.StarAmount, error) {
+	balance := &types.StarAmount{}
+	err := b.rawRequest(ctx, "getBusinessAccountStarBalance", params, balance)
+	return balance, err
+}
+
+// TransferBusinessAccountStars https://core.telegram.org/bots/api#transferbusinessaccountstars
+func (b *GramGoBot) TransferBusinessAccountStars(ctx context.Context, params *types.TransferBusinessAccountStarsParams) error {
+	var result bool
+	return b.rawRequest(ctx, "transferBusinessAccountStars", params, &result)
+}
+
+// GetAvailableGifts https://core.telegram.org/bots/api#getavailablegifts
+func (b *GramGoBot) GetAvailableGifts(ctx context.Context) (*types.Gifts, error) {
+	gifts := &types.Gifts{}
+	err := b.rawRequest(ctx, "getAvailableGifts", nil, gifts)
+	return gifts, err
+}
+
+// SendGift https://core.telegram.org/bots/api#sendgift
+func (b *GramGoBot) SendGift(ctx context.Context, params *types.SendGiftParams) error {
+	var result bool
+	return b.rawRequest(ctx, "sendGift", params, &result)
+}
+
+// GiftPremiumSubscription https://core.telegram.org/bots/api#giftpremiumsubscription
+func (b *GramGoBot) GiftPremiumSubscription(ctx context.Context, params *types.GiftPremiumSubscriptionParams) error {
+	var result bool
+	return b.rawRequest(ctx, "giftPremiumSubscription", params, &result)
+}
+
+// GetBusinessAccountGifts https://core.telegram.org/bots/api#getbusinessaccountgifts
+func (b *GramGoBot) GetBusinessAccountGifts(ctx context.Context, params *types.GetBusinessAccountGiftsParams) (*types.OwnedGifts, error) {
+	gifts := &types.OwnedGifts{}
+	err := b.rawRequest(ctx, "getBusinessAccountGifts", params, gifts)
+	return gifts, err
+}
+
+// AllBusinessAccountGifts iterates over every gift matching params, fetching
+// further pages by following NextOffset. Iteration stops after the first
+// error, which is yielded with a zero OwnedGift.
+//
+// Example:
+//
+//	for gift, err := range bot.AllBusinessAccountGifts(ctx, params) {
+//		if err != nil {
+//			return err
+//		}
+//		// Do smth with gift
+//	}
+func (b *GramGoBot) AllBusinessAccountGifts(ctx context.Context, params *types.GetBusinessAccountGiftsParams) iter.Seq2[types.OwnedGift, error] {
+	return func(yield func(types.OwnedGift, error) bool) {
+		page := *params
+
+		for {
+			gifts, err := b.GetBusinessAccountGifts(ctx, &page)
+			if err != nil {
+				yield(types.OwnedGift{}, err)
+				return
+			}
+
+			for _, gift := range gifts.Gifts {
+				if !yield(gift, nil) {
+					return
+				}
+			}
+
+			if gifts.NextOffset == "" || len(gifts.Gifts) == 0 {
+				return
+			}
+			page.Offset = gifts.NextOffset
+		}
+	}
+}
+
+// ConvertGiftToStars https://core.telegram.org/bots/api#convertgifttostars
+func (b *GramGoBot) ConvertGiftToStars(ctx context.Context, params *types.ConvertGiftToStarsParams) error {
+	var result bool
+	return b.rawRequest(ctx, "convertGiftToStars", params, &result)
+}
+
+// UpgradeGift https://core.telegram.org/bots/api#upgradegift
+func (b *GramGoBot) UpgradeGift(ctx context.Context, params *types.UpgradeGiftParams) error {
+	var result bool
+	return b.rawRequest(ctx, "upgradeGift", params, &result)
+}
+
+// TransferGift https://core.telegram.org/bots/api#transfergift
+func (b *GramGoBot) TransferGift(ctx context.Context, params *types.TransferGiftParams) error {
+	var result bool
+	return b.rawRequest(ctx, "transferGift", params, &result)
+}
+
+// PostStory https://core.telegram.org/bots/api#poststory
+func (b *GramGoBot) PostStory(ctx context.Context, params *types.PostStoryParams) (*types.Story, error) {
+	story := &types.Story{}
+	err := b.rawRequest(ctx, "postStory", params, story)
+	return story, err
+}
+
+// EditStory https://core.telegram.org/bots/api#editstory
+func (b *GramGoBot) EditStory(ctx context.Context, params *types.EditStoryParams) (*types.Story, error) {
+	story := &types.Story{}
+	err := b.rawRequest(ctx, "editStory", params, story)
+	return story, err
+}
+
+// DeleteStory https://core.telegram.org/bots/api#deletestory
+func (b *GramGoBot) DeleteStory(ctx context.Context, params *types.DeleteStoryParams) error {
+	var result bool
+	return b.rawRequest(ctx, "deleteStory", params, &result)
+}
+
+// GetFile https://core.telegram.org/bots/api#getfile
+func (b *GramGoBot) GetFile(ctx context.Context, params *types.GetFileParams) (*types.File, error) {
+	file := &types.File{}
+	err := b.rawRequest(ctx, "getFile", params, file)
+	return file, err
+}
@@ -0,0 +1,71 @@
+package gramgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/OhMyDitzzy/gramgo/types"
+)
+
+func TestDeleteMessages_chunks(t *testing.T) {
+	var mu sync.Mutex
+	var chunks [][]int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params types.DeleteMessagesParams
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			t.Error(err)
+		}
+
+		mu.Lock()
+		chunks = append(chunks, params.MessageIDs)
+		mu.Unlock()
+
+		// Fail the second chunk only
+		if params.MessageIDs[0] == 101 {
+			w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: message can't be deleted"}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	defer srv.Close()
+
+	bot, err := NewBot(Config{Token: "T", APIBaseURL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make([]int, 250)
+	for i := range ids {
+		ids[i] = i + 1
+	}
+
+	err = bot.DeleteMessages(context.Background(), &types.DeleteMessagesParams{
+		ChatID:     1,
+		MessageIDs: ids,
+	})
+
+	if len(chunks) != 3 {
+		t.Fatal("wrong requests count", len(chunks))
+	}
+
+	for i, want := range []struct{ first, size int }{{1, 100}, {101, 100}, {201, 50}} {
+		if len(chunks[i]) != want.size || chunks[i][0] != want.first {
+			t.Fatalf("wrong chunk %d: %d ids starting at %d", i, len(chunks[i]), chunks[i][0])
+		}
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != 400 {
+		t.Fatal("expected the failed chunk error, got", err)
+	}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); !ok || len(joined.Unwrap()) != 1 {
+		t.Fatal("expected a joined error with one failed chunk, got", err)
+	}
+}
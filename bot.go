@@ -3,26 +3,38 @@ package gramgo
 import (
 	"context"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/OhMyDitzzy/gramgo/types"
 )
 
 type GramGoBot struct {
-	token      string
-	apiURL     string
-	middleware []MiddlewareFunc
-	handlers   map[string][]handler
-	client     *http.Client
-	stopChan   chan struct{}
-	isRunning  bool
+	token           string
+	apiURL          string
+	fileURL         string
+	middleware      []MiddlewareFunc
+	interceptors    []InterceptorFunc
+	handlers        map[string][]handler
+	client          *http.Client
+	retry           RetryConfig
+	scheduler       *scheduler
+	followMigration bool
+	migrationHooks  []ChatMigratedFunc
+	migrations      map[int64]int64
+	migrationMu     sync.Mutex
+	stopChan        chan struct{}
+	isRunning       bool
 }
 
 type Config struct {
-	Token      string
-	APIBaseURL string        // default: https://api.telegram.org
-	Timeout    time.Duration // default: 30s
-	Client     *http.Client  // optional custom http client
+	Token               string
+	APIBaseURL          string           // default: https://api.telegram.org
+	Timeout             time.Duration    // default: 30s
+	Client              *http.Client     // optional custom http client
+	Retry               RetryConfig      // optional retry policy (default: no retries)
+	Scheduler           *SchedulerConfig // optional outbound rate limiting (default: disabled)
+	FollowChatMigration bool             // resend requests to the new chat ID after a group migrated to a supergroup, buffering uploads in memory
 }
 
 // NewBot create a new bot instance
@@ -55,12 +67,20 @@ func NewBot(config Config) (*GramGoBot, error) {
 	}
 
 	bot := &GramGoBot{
-		token:     config.Token,
-		apiURL:    config.APIBaseURL + "/bot" + config.Token,
-		client:    client,
-		handlers:  make(map[string][]handler),
-		stopChan:  make(chan struct{}),
-		isRunning: false,
+		token:           config.Token,
+		apiURL:          config.APIBaseURL + "/bot" + config.Token,
+		fileURL:         config.APIBaseURL + "/file/bot" + config.Token,
+		client:          client,
+		retry:           config.Retry.withDefaults(),
+		followMigration: config.FollowChatMigration,
+		migrations:      make(map[int64]int64),
+		handlers:        make(map[string][]handler),
+		stopChan:        make(chan struct{}),
+		isRunning:       false,
+	}
+
+	if config.Scheduler != nil {
+		bot.scheduler = newScheduler(*config.Scheduler)
 	}
 
 	return bot, nil
@@ -0,0 +1,147 @@
+package gramgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/OhMyDitzzy/gramgo/types"
+)
+
+// DownloadConfig holds download configuration
+type DownloadConfig struct {
+	MaxSize int64 // Maximum file size in bytes (default: no limit)
+	Offset  int64 // Number of bytes already downloaded, resumed with an HTTP Range request
+}
+
+// DownloadFile fetches the file with the given file ID and streams its
+// content into w. The file metadata returned by getFile is returned as well.
+//
+// When Offset is set, only the bytes after Offset are written, so a partial
+// download can be resumed by appending to what was already written.
+//
+// Example:
+//
+//	f, err := os.OpenFile("voice.ogg", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+//	if err != nil {
+//		// Do smth
+//	}
+//	defer f.Close()
+//
+//	info, _ := f.Stat()
+//	_, err = bot.DownloadFile(ctx, fileID, f, gramgo.DownloadConfig{
+//		MaxSize: 20 << 20,
+//		Offset:  info.Size(),
+//	})
+func (b *GramGoBot) DownloadFile(ctx context.Context, fileID string, w io.Writer, configs ...DownloadConfig) (*types.File, error) {
+	var config DownloadConfig
+	if len(configs) > 0 {
+		config = configs[0]
+	}
+
+	if config.Offset < 0 {
+		return nil, errors.New("download offset cannot be negative")
+	}
+
+	file, err := b.GetFile(ctx, &types.GetFileParams{FileID: fileID})
+	if err != nil {
+		return nil, err
+	}
+
+	if file.FilePath == "" {
+		return file, fmt.Errorf("file %s has no file path", fileID)
+	}
+
+	if config.MaxSize > 0 && file.FileSize > config.MaxSize {
+		return file, ErrFileTooLarge
+	}
+
+	if config.Offset > 0 && file.FileSize > 0 && config.Offset >= file.FileSize {
+		// Nothing left to download
+		return file, nil
+	}
+
+	if err := b.downloadFilePath(ctx, file.FilePath, w, config); err != nil {
+		return file, err
+	}
+
+	return file, nil
+}
+
+// Download is a shorthand for DownloadFile that takes the file ID from
+// anything that references a file, such as *types.PhotoSize or
+// *types.Document.
+func (b *GramGoBot) Download(ctx context.Context, file types.Downloadable, w io.Writer, configs ...DownloadConfig) (*types.File, error) {
+	if isNilOrEmpty(file) {
+		return nil, errors.New("file to download is nil")
+	}
+
+	return b.DownloadFile(ctx, file.GetFileID(), w, configs...)
+}
+
+func (b *GramGoBot) downloadFilePath(ctx context.Context, filePath string, w io.Writer, config DownloadConfig) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.fileURL+"/"+filePath, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build download request for %s: %w", filePath, err)
+	}
+
+	if config.Offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", config.Offset))
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", filePath, err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			fmt.Printf("warning: failed to close response body: %v\n", closeErr)
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The offset is at the end of the file, which happens when FileSize
+		// is unknown and the file was already downloaded
+		if config.Offset > 0 {
+			return nil
+		}
+		return fmt.Errorf("failed to download %s: unexpected status %s", filePath, resp.Status)
+	case http.StatusOK:
+		// The server ignored the Range header and sent the whole file
+		if config.Offset > 0 {
+			if _, err := io.CopyN(io.Discard, resp.Body, config.Offset); err != nil {
+				return fmt.Errorf("failed to skip %d bytes of %s: %w", config.Offset, filePath, err)
+			}
+		}
+	default:
+		return fmt.Errorf("failed to download %s: unexpected status %s", filePath, resp.Status)
+	}
+
+	if config.MaxSize <= 0 {
+		if _, err := io.Copy(w, resp.Body); err != nil {
+			return fmt.Errorf("failed to download %s: %w", filePath, err)
+		}
+		return nil
+	}
+
+	remaining := config.MaxSize - config.Offset
+	if remaining < 0 {
+		return ErrFileTooLarge
+	}
+
+	if _, err := io.Copy(w, io.LimitReader(resp.Body, remaining)); err != nil {
+		return fmt.Errorf("failed to download %s: %w", filePath, err)
+	}
+
+	// The reported file size may be missing, so make sure the body ended
+	// within the limit.
+	if n, _ := resp.Body.Read(make([]byte, 1)); n > 0 {
+		return ErrFileTooLarge
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package gramgo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+const downloadContent = "0123456789abcdef"
+
+// newDownloadServer serves getFile with the given file size and the file
+// itself through handler.
+func newDownloadServer(t *testing.T, fileSize int, handler http.HandlerFunc) (*GramGoBot, *atomic.Int32) {
+	t.Helper()
+
+	var downloads atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/getFile") {
+			fmt.Fprintf(w, `{"ok":true,"result":{"file_id":"id","file_unique_id":"u","file_size":%d,"file_path":"documents/file.txt"}}`, fileSize)
+			return
+		}
+
+		if r.URL.Path != "/file/botT/documents/file.txt" {
+			t.Error("wrong file path", r.URL.Path)
+		}
+
+		downloads.Add(1)
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	bot, err := NewBot(Config{Token: "T", APIBaseURL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return bot, &downloads
+}
+
+// serveRange answers Range requests with 206 Partial Content.
+func serveRange(w http.ResponseWriter, r *http.Request) {
+	offset := 0
+	if header := r.Header.Get("Range"); header != "" {
+		offset, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(header, "bytes="), "-"))
+		if offset >= len(downloadContent) {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		w.WriteHeader(http.StatusPartialContent)
+	}
+
+	w.Write([]byte(downloadContent[offset:]))
+}
+
+// serveIgnoringRange always answers with the whole file.
+func serveIgnoringRange(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(downloadContent))
+}
+
+func TestDownloadFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileSize int
+		handler  http.HandlerFunc
+		config   DownloadConfig
+		want     string
+		wantErr  error
+	}{
+		{"full", 16, serveRange, DownloadConfig{}, downloadContent, nil},
+		{"partial content", 16, serveRange, DownloadConfig{Offset: 10}, "abcdef", nil},
+		{"range ignored", 16, serveIgnoringRange, DownloadConfig{Offset: 10}, "abcdef", nil},
+		{"within max size", 16, serveRange, DownloadConfig{MaxSize: 16}, downloadContent, nil},
+		{"file size over max size", 16, serveRange, DownloadConfig{MaxSize: 8}, "", ErrFileTooLarge},
+		{"unknown size over max size", 0, serveIgnoringRange, DownloadConfig{MaxSize: 8}, "", ErrFileTooLarge},
+		{"unknown size already downloaded", 0, serveRange, DownloadConfig{Offset: 16}, "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, _ := newDownloadServer(t, tt.fileSize, tt.handler)
+
+			var buf bytes.Buffer
+			_, err := bot.DownloadFile(context.Background(), "id", &buf, tt.config)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && buf.String() != tt.want {
+				t.Fatalf("got %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDownloadFile_alreadyDownloaded(t *testing.T) {
+	bot, downloads := newDownloadServer(t, 16, serveRange)
+
+	var buf bytes.Buffer
+	if _, err := bot.DownloadFile(context.Background(), "id", &buf, DownloadConfig{Offset: 16}); err != nil {
+		t.Fatal(err)
+	}
+
+	if downloads.Load() != 0 || buf.Len() != 0 {
+		t.Fatal("file was downloaded again")
+	}
+}
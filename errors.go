@@ -3,6 +3,8 @@ package gramgo
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/OhMyDitzzy/gramgo/types"
 )
@@ -16,13 +18,99 @@ var (
 
 	// ErrNoHandler is returned when no handler is registered
 	ErrNoHandler = errors.New("no handler registered")
+
+	// ErrFileTooLarge is returned when a download exceeds DownloadConfig.MaxSize
+	ErrFileTooLarge = errors.New("file exceeds the maximum download size")
+
+	// ErrInvalidCertificate is returned when the webhook certificate cannot be parsed
+	ErrInvalidCertificate = errors.New("invalid webhook certificate")
+
+	// ErrCertificateHostMismatch is returned when the webhook certificate does not match the webhook URL
+	ErrCertificateHostMismatch = errors.New("webhook certificate does not match the webhook URL")
 )
 
+// Known Bot API failures, matched with errors.Is against an *APIError
+var (
+	// ErrBotBlocked is returned when the user blocked the bot
+	ErrBotBlocked = errors.New("bot was blocked by the user")
+
+	// ErrBotKicked is returned when the bot was removed from the group or channel
+	ErrBotKicked = errors.New("bot was kicked from the chat")
+
+	// ErrChatNotFound is returned when the chat does not exist or the bot cannot access it
+	ErrChatNotFound = errors.New("chat not found")
+
+	// ErrMessageNotModified is returned when an edit does not change the message
+	ErrMessageNotModified = errors.New("message is not modified")
+
+	// ErrMessageToEditNotFound is returned when the message to edit does not exist
+	ErrMessageToEditNotFound = errors.New("message to edit not found")
+
+	// ErrMessageToDeleteNotFound is returned when the message to delete does not exist
+	ErrMessageToDeleteNotFound = errors.New("message to delete not found")
+
+	// ErrNotEnoughRights is returned when the bot lacks the administrator rights for the action
+	ErrNotEnoughRights = errors.New("not enough rights")
+
+	// ErrUserDeactivated is returned when the user account was deleted
+	ErrUserDeactivated = errors.New("user is deactivated")
+
+	// ErrWebhookConflict is returned when getUpdates is called while a webhook is set
+	ErrWebhookConflict = errors.New("webhook is active")
+
+	// ErrTooManyRequests is returned when the bot hit a flood limit
+	ErrTooManyRequests = errors.New("too many requests")
+)
+
+// descriptionErrors maps substrings of lowercased API error descriptions to
+// sentinel errors. The first match wins.
+var descriptionErrors = []struct {
+	substr string
+	err    error
+}{
+	{"bot was blocked by the user", ErrBotBlocked},
+	{"bot was kicked from", ErrBotKicked},
+	{"chat not found", ErrChatNotFound},
+	{"message is not modified", ErrMessageNotModified},
+	{"message to edit not found", ErrMessageToEditNotFound},
+	{"message to delete not found", ErrMessageToDeleteNotFound},
+	{"not enough rights", ErrNotEnoughRights},
+	{"have no rights", ErrNotEnoughRights},
+	{"need administrator rights", ErrNotEnoughRights},
+	{"chat_admin_required", ErrNotEnoughRights},
+	{"user is deactivated", ErrUserDeactivated},
+	{"webhook is active", ErrWebhookConflict},
+	{"too many requests", ErrTooManyRequests},
+}
+
+// classifyAPIError returns the sentinel error matching an API error, or nil
+// if the failure is not a known one.
+func classifyAPIError(code int, description string) error {
+	description = strings.ToLower(description)
+	for _, d := range descriptionErrors {
+		if strings.Contains(description, d.substr) {
+			return d.err
+		}
+	}
+
+	if code == http.StatusTooManyRequests {
+		return ErrTooManyRequests
+	}
+
+	return nil
+}
+
 // APIError represents an error from Telegram Bot API
+//
+// Known failures can be matched with errors.Is, e.g.
+// errors.Is(err, gramgo.ErrBotBlocked).
 type APIError struct {
-	Code        int
-	Description string
-	Parameters  *types.ResponseParameters
+	Method         string
+	Code           int
+	Description    string
+	RawDescription string // Description as returned by Telegram
+	Parameters     *types.ResponseParameters
+	Err            error // Matching sentinel error such as ErrBotBlocked, if any
 }
 
 func (e *APIError) Error() string {
@@ -39,6 +127,10 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("telegram api error %d: %s", e.Code, e.Description)
 }
 
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
 // IsRetryableError checks if the error is retryable
 func IsRetryableError(err error) bool {
 	var apiErr *APIError
@@ -57,4 +149,4 @@ func GetRetryAfter(err error) int {
 		}
 	}
 	return 0
-}
\ No newline at end of file
+}
@@ -0,0 +1,59 @@
+package gramgo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/OhMyDitzzy/gramgo/types"
+)
+
+func TestHandleAPIError_classify(t *testing.T) {
+	tests := []struct {
+		code        int
+		description string
+		want        error
+	}{
+		{403, "Forbidden: bot was blocked by the user", ErrBotBlocked},
+		{403, "Forbidden: bot was kicked from the group chat", ErrBotKicked},
+		{403, "Forbidden: bot was kicked from the supergroup chat", ErrBotKicked},
+		{400, "Bad Request: chat not found", ErrChatNotFound},
+		{400, "Bad Request: message is not modified: specified new message content and reply markup are exactly the same as a current content and reply markup of the message", ErrMessageNotModified},
+		{400, "Bad Request: message to edit not found", ErrMessageToEditNotFound},
+		{400, "Bad Request: message to delete not found", ErrMessageToDeleteNotFound},
+		{400, "Bad Request: not enough rights to send text messages to the chat", ErrNotEnoughRights},
+		{400, "Bad Request: have no rights to send a message", ErrNotEnoughRights},
+		{400, "Bad Request: need administrator rights in the channel chat", ErrNotEnoughRights},
+		{400, "Bad Request: CHAT_ADMIN_REQUIRED", ErrNotEnoughRights},
+		{403, "Forbidden: user is deactivated", ErrUserDeactivated},
+		{409, "Conflict: can't use getUpdates method while webhook is active; use deleteWebhook to delete the webhook first", ErrWebhookConflict},
+		{429, "Too Many Requests: retry after 35", ErrTooManyRequests},
+		{429, "Flood control exceeded", ErrTooManyRequests},
+		{400, "Bad Request: message text is empty", nil},
+	}
+
+	b := &GramGoBot{}
+
+	for _, tt := range tests {
+		err := b.handleAPIError("sendMessage", &types.APIResponse{
+			ErrorCode:   tt.code,
+			Description: tt.description,
+		})
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatal("expected *APIError for", tt.description)
+		}
+
+		if apiErr.Err != tt.want {
+			t.Fatalf("%q: got %v, want %v", tt.description, apiErr.Err, tt.want)
+		}
+
+		if tt.want != nil && !errors.Is(err, tt.want) {
+			t.Fatalf("%q: errors.Is failed for %v", tt.description, tt.want)
+		}
+
+		if apiErr.Method != "sendMessage" || apiErr.Code != tt.code || apiErr.RawDescription != tt.description {
+			t.Fatal("original error details are not kept for", tt.description)
+		}
+	}
+}
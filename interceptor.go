@@ -0,0 +1,31 @@
+package gramgo
+
+import "context"
+
+// RequestFunc performs an API call. params is the request payload and
+// result points to the value the API result is decoded into.
+type RequestFunc func(ctx context.Context, method string, params any, result any) error
+
+// InterceptorFunc wraps outgoing API calls, the way MiddlewareFunc wraps
+// update handlers. An interceptor can change the method, params or context
+// before calling next, skip next and fill result itself, or inspect the
+// outcome after next returns.
+type InterceptorFunc func(next RequestFunc) RequestFunc
+
+// UseInterceptor adds interceptors around every API call. Interceptors run in
+// the order they were added, the first one being the outermost. They should be
+// added before the bot starts making requests.
+//
+// Example:
+//
+//	bot.UseInterceptor(func(next gramgo.RequestFunc) gramgo.RequestFunc {
+//		return func(ctx context.Context, method string, params any, result any) error {
+//			start := time.Now()
+//			err := next(ctx, method, params, result)
+//			log.Printf("%s took %v: %v", method, time.Since(start), err)
+//			return err
+//		}
+//	})
+func (b *GramGoBot) UseInterceptor(interceptors ...InterceptorFunc) {
+	b.interceptors = append(b.interceptors, interceptors...)
+}
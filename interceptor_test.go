@@ -0,0 +1,114 @@
+package gramgo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/OhMyDitzzy/gramgo/types"
+)
+
+func TestUseInterceptor(t *testing.T) {
+	var requests atomic.Int32
+	var sentText string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		var params map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			t.Error(err)
+		}
+		sentText, _ = params["text"].(string)
+
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1,"date":0,"chat":{"id":1,"type":"private"}}}`))
+	}))
+	defer srv.Close()
+
+	bot, err := NewBot(Config{Token: "T", APIBaseURL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var order []string
+	record := func(name string) InterceptorFunc {
+		return func(next RequestFunc) RequestFunc {
+			return func(ctx context.Context, method string, params any, result any) error {
+				order = append(order, name+" before")
+				err := next(ctx, method, params, result)
+				order = append(order, name+" after")
+				return err
+			}
+		}
+	}
+
+	// Answers getChat from a cache without calling the API
+	cache := func(next RequestFunc) RequestFunc {
+		return func(ctx context.Context, method string, params any, result any) error {
+			if method != "getChat" {
+				return next(ctx, method, params, result)
+			}
+
+			return json.Unmarshal([]byte(`{"id":7,"type":"group","title":"Cached"}`), result)
+		}
+	}
+
+	// Tags every message before it is sent and keeps the sent message
+	var sent *types.Message
+	tag := func(next RequestFunc) RequestFunc {
+		return func(ctx context.Context, method string, params any, result any) error {
+			p, ok := params.(*types.SendMessageParams)
+			if !ok {
+				return next(ctx, method, params, result)
+			}
+
+			tagged := *p
+			tagged.Text = "[tag] " + p.Text
+			err := next(ctx, method, &tagged, result)
+			sent, _ = result.(*types.Message)
+			return err
+		}
+	}
+
+	bot.UseInterceptor(record("outer"), record("inner"), cache, tag)
+
+	chat, err := bot.GetChat(context.Background(), &types.GetChatParams{ChatID: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if chat.Title != "Cached" || requests.Load() != 0 {
+		t.Fatal("getChat was not answered from the cache")
+	}
+
+	want := []string{"outer before", "inner before", "inner after", "outer after"}
+	if len(order) != len(want) {
+		t.Fatal("wrong order", order)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatal("wrong order", order)
+		}
+	}
+
+	params := &types.SendMessageParams{ChatID: 1, Text: "hi"}
+	msg, err := bot.SendMessage(context.Background(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sent == nil || sent != msg || sent.ID != 1 {
+		t.Fatal("interceptor did not see the sent message")
+	}
+
+	if requests.Load() != 1 || sentText != "[tag] hi" {
+		t.Fatal("params were not changed by the interceptor", sentText)
+	}
+
+	if params.Text != "hi" {
+		t.Fatal("caller's params were changed")
+	}
+}
@@ -0,0 +1,207 @@
+package gramgo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"reflect"
+	"strconv"
+
+	"github.com/OhMyDitzzy/gramgo/types"
+)
+
+// ChatMigratedFunc is called when a group has been migrated to a supergroup
+type ChatMigratedFunc func(ctx context.Context, oldChatID, newChatID int64)
+
+// OnChatMigrated registers a hook that is called once per migrated group,
+// either when a request fails because the group was migrated or when the
+// migrate_to_chat_id or migrate_from_chat_id service message is received.
+// Use it to update stored chat IDs.
+//
+// Example:
+//
+//	bot.OnChatMigrated(func(ctx context.Context, oldChatID, newChatID int64) {
+//		db.UpdateChatID(ctx, oldChatID, newChatID)
+//	})
+func (b *GramGoBot) OnChatMigrated(hook ChatMigratedFunc) {
+	b.migrationHooks = append(b.migrationHooks, hook)
+}
+
+// chatMigrated fires the migration hooks, unless the migration was already
+// reported.
+func (b *GramGoBot) chatMigrated(ctx context.Context, oldChatID, newChatID int64) {
+	b.migrationMu.Lock()
+	if b.migrations[oldChatID] == newChatID {
+		b.migrationMu.Unlock()
+		return
+	}
+	b.migrations[oldChatID] = newChatID
+	b.migrationMu.Unlock()
+
+	for _, hook := range b.migrationHooks {
+		hook(ctx, oldChatID, newChatID)
+	}
+}
+
+// handleChatMigration reports migrations announced by service messages.
+func (b *GramGoBot) handleChatMigration(ctx context.Context, update *types.Update) {
+	msg := update.Message
+	if msg == nil {
+		return
+	}
+
+	if msg.MigrateToChatID != 0 {
+		b.chatMigrated(ctx, msg.Chat.ID, msg.MigrateToChatID)
+	}
+	if msg.MigrateFromChatID != 0 {
+		b.chatMigrated(ctx, msg.MigrateFromChatID, msg.Chat.ID)
+	}
+}
+
+// followChatMigration handles a request that failed with err. When the chat
+// of params was migrated to a supergroup, the hooks are fired and, if
+// Config.FollowChatMigration is set, the request is sent again to the new
+// chat. Otherwise err is returned unchanged. body is the buffered multipart
+// body of params, if any.
+func (b *GramGoBot) followChatMigration(ctx context.Context, method string, params any, body *bufferedBody, result any, err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Parameters == nil || apiErr.Parameters.MigrateToChatID == 0 {
+		return err
+	}
+
+	field, ok := chatIDField(params)
+	if !ok || !field.CanInt() {
+		return err
+	}
+
+	newChatID := apiErr.Parameters.MigrateToChatID
+	b.chatMigrated(ctx, field.Int(), newChatID)
+
+	if !b.followMigration {
+		return err
+	}
+
+	migrated, ok := withChatID(params, newChatID)
+	if !ok {
+		return err
+	}
+
+	if body != nil {
+		body, err = body.withChatID(newChatID)
+		if err != nil {
+			return fmt.Errorf("failed to build request for %s: %w", method, err)
+		}
+	}
+
+	return b.sendRequest(ctx, method, migrated, body, result)
+}
+
+// chatIDField returns the concrete value of the ChatID field of params.
+func chatIDField(params any) (reflect.Value, bool) {
+	v := reflect.ValueOf(params)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	field := v.FieldByName("ChatID")
+	for field.IsValid() && (field.Kind() == reflect.Interface || field.Kind() == reflect.Pointer) {
+		if field.IsNil() {
+			return reflect.Value{}, false
+		}
+		field = field.Elem()
+	}
+
+	return field, field.IsValid()
+}
+
+// withChatID returns a copy of params with ChatID set to chatID, leaving the
+// caller's params untouched.
+func withChatID(params any, chatID int64) (any, bool) {
+	v := reflect.ValueOf(params)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	copied := reflect.New(v.Type())
+	copied.Elem().Set(v)
+
+	field := copied.Elem().FieldByName("ChatID")
+	switch {
+	case field.Kind() == reflect.Interface:
+		field.Set(reflect.ValueOf(chatID))
+	case field.CanInt():
+		field.SetInt(chatID)
+	default:
+		return nil, false
+	}
+
+	return copied.Interface(), true
+}
+
+// withChatID returns a copy of the body with its chat_id part set to chatID.
+// The uploads are copied as they are.
+func (body *bufferedBody) withChatID(chatID int64) (*bufferedBody, error) {
+	_, mediaParams, err := mime.ParseMediaType(body.contentType)
+	if err != nil {
+		return nil, err
+	}
+	boundary := mediaParams["boundary"]
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return nil, err
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(body.data), boundary)
+	for {
+		part, err := reader.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if part.FormName() == "chat_id" {
+			err = writer.WriteField("chat_id", strconv.FormatInt(chatID, 10))
+		} else {
+			err = copyPart(writer, part)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return &bufferedBody{data: buf.Bytes(), contentType: body.contentType}, nil
+}
+
+// copyPart writes part, headers included, to writer.
+func copyPart(writer *multipart.Writer, part *multipart.Part) error {
+	w, err := writer.CreatePart(part.Header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, part)
+	return err
+}
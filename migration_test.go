@@ -0,0 +1,200 @@
+package gramgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/OhMyDitzzy/gramgo/types"
+)
+
+const migratedResponse = `{"ok":false,"error_code":400,"description":"Bad Request: group chat was upgraded to a supergroup chat","parameters":{"migrate_to_chat_id":-1005}}`
+
+// newMigrationServer answers requests to chat -5 with a migration error and
+// records the chat_id of every request.
+func newMigrationServer(t *testing.T, config Config) (*GramGoBot, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var chatIDs []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var chatID string
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+			chatID = r.FormValue("chat_id")
+		} else {
+			var params struct {
+				ChatID json.Number `json:"chat_id"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+				t.Error(err)
+			}
+			chatID = params.ChatID.String()
+		}
+
+		mu.Lock()
+		chatIDs = append(chatIDs, chatID)
+		mu.Unlock()
+
+		if chatID == "-5" {
+			w.Write([]byte(migratedResponse))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1,"date":0,"chat":{"id":-1005,"type":"supergroup"}}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	config.Token = "T"
+	config.APIBaseURL = srv.URL
+	bot, err := NewBot(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return bot, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), chatIDs...)
+	}
+}
+
+type migration struct {
+	oldChatID, newChatID int64
+}
+
+func recordMigrations(bot *GramGoBot) *[]migration {
+	var migrations []migration
+	bot.OnChatMigrated(func(ctx context.Context, oldChatID, newChatID int64) {
+		migrations = append(migrations, migration{oldChatID, newChatID})
+	})
+	return &migrations
+}
+
+func TestFollowChatMigration(t *testing.T) {
+	bot, chatIDs := newMigrationServer(t, Config{FollowChatMigration: true})
+	migrations := recordMigrations(bot)
+
+	params := &types.SendMessageParams{ChatID: int64(-5), Text: "hi"}
+	msg, err := bot.SendMessage(context.Background(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if msg.Chat.ID != -1005 {
+		t.Fatal("wrong chat", msg.Chat.ID)
+	}
+
+	if got := chatIDs(); len(got) != 2 || got[0] != "-5" || got[1] != "-1005" {
+		t.Fatal("request was not sent again to the new chat", got)
+	}
+
+	if params.ChatID != int64(-5) {
+		t.Fatal("caller's params were changed")
+	}
+
+	// Both service messages of the same migration arrive afterwards
+	bot.handleUpdate(context.Background(), &types.Update{
+		Message: &types.Message{Chat: types.Chat{ID: -5}, MigrateToChatID: -1005},
+	})
+	bot.handleUpdate(context.Background(), &types.Update{
+		Message: &types.Message{Chat: types.Chat{ID: -1005}, MigrateFromChatID: -5},
+	})
+
+	if len(*migrations) != 1 || (*migrations)[0] != (migration{-5, -1005}) {
+		t.Fatal("hook was not called exactly once", *migrations)
+	}
+}
+
+func TestChatMigration_serviceMessages(t *testing.T) {
+	bot, _ := newMigrationServer(t, Config{})
+	migrations := recordMigrations(bot)
+
+	bot.handleUpdate(context.Background(), &types.Update{
+		Message: &types.Message{Chat: types.Chat{ID: -1007}, MigrateFromChatID: -7},
+	})
+	bot.handleUpdate(context.Background(), &types.Update{
+		Message: &types.Message{Chat: types.Chat{ID: -7}, MigrateToChatID: -1007},
+	})
+
+	if len(*migrations) != 1 || (*migrations)[0] != (migration{-7, -1007}) {
+		t.Fatal("hook was not called exactly once", *migrations)
+	}
+}
+
+func TestChatMigration_disabled(t *testing.T) {
+	bot, chatIDs := newMigrationServer(t, Config{})
+	migrations := recordMigrations(bot)
+
+	_, err := bot.SendMessage(context.Background(), &types.SendMessageParams{ChatID: int64(-5), Text: "hi"})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Parameters.MigrateToChatID != -1005 {
+		t.Fatal("expected the migration error, got", err)
+	}
+
+	if got := chatIDs(); len(got) != 1 {
+		t.Fatal("request was sent again", got)
+	}
+
+	if len(*migrations) != 1 {
+		t.Fatal("hook was not called", *migrations)
+	}
+}
+
+func TestFollowChatMigration_multipart(t *testing.T) {
+	var mu sync.Mutex
+	var sent []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, _, err := r.FormFile("document")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		content, _ := io.ReadAll(file)
+
+		chatID := r.FormValue("chat_id")
+		mu.Lock()
+		sent = append(sent, chatID+":"+string(content))
+		mu.Unlock()
+
+		if chatID == "-5" {
+			w.Write([]byte(migratedResponse))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1,"date":0,"chat":{"id":-1005,"type":"supergroup"}}}`))
+	}))
+	defer srv.Close()
+
+	bot, err := NewBot(Config{Token: "T", APIBaseURL: srv.URL, FollowChatMigration: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	migrations := recordMigrations(bot)
+
+	msg, err := bot.SendDocument(context.Background(), &types.SendDocumentParams{
+		ChatID:   int64(-5),
+		Document: &types.InputFileUpload{Filename: "file.txt", Data: strings.NewReader("content")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if msg.Chat.ID != -1005 {
+		t.Fatal("wrong result", msg.Chat.ID)
+	}
+
+	if len(sent) != 2 || sent[0] != "-5:content" || sent[1] != "-1005:content" {
+		t.Fatal("upload was not sent again to the new chat", sent)
+	}
+
+	if len(*migrations) != 1 {
+		t.Fatal("hook was not called", *migrations)
+	}
+}
@@ -0,0 +1,105 @@
+package passport
+
+import (
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+
+	"github.com/OhMyDitzzy/gramgo/types"
+)
+
+// Credentials https://core.telegram.org/passport#credentials
+type Credentials struct {
+	SecureData SecureData `json:"secure_data"`
+	Nonce      string     `json:"nonce"`
+}
+
+// SecureData https://core.telegram.org/passport#securedata
+type SecureData struct {
+	PersonalDetails       *SecureValue `json:"personal_details,omitempty"`
+	Passport              *SecureValue `json:"passport,omitempty"`
+	InternalPassport      *SecureValue `json:"internal_passport,omitempty"`
+	DriverLicense         *SecureValue `json:"driver_license,omitempty"`
+	IdentityCard          *SecureValue `json:"identity_card,omitempty"`
+	Address               *SecureValue `json:"address,omitempty"`
+	UtilityBill           *SecureValue `json:"utility_bill,omitempty"`
+	BankStatement         *SecureValue `json:"bank_statement,omitempty"`
+	RentalAgreement       *SecureValue `json:"rental_agreement,omitempty"`
+	PassportRegistration  *SecureValue `json:"passport_registration,omitempty"`
+	TemporaryRegistration *SecureValue `json:"temporary_registration,omitempty"`
+}
+
+// SecureValue https://core.telegram.org/passport#securevalue
+type SecureValue struct {
+	Data        *DataCredentials  `json:"data,omitempty"`
+	FrontSide   *FileCredentials  `json:"front_side,omitempty"`
+	ReverseSide *FileCredentials  `json:"reverse_side,omitempty"`
+	Selfie      *FileCredentials  `json:"selfie,omitempty"`
+	Translation []FileCredentials `json:"translation,omitempty"`
+	Files       []FileCredentials `json:"files,omitempty"`
+}
+
+// DataCredentials https://core.telegram.org/passport#datacredentials
+type DataCredentials struct {
+	DataHash string `json:"data_hash"`
+	Secret   string `json:"secret"`
+}
+
+// FileCredentials https://core.telegram.org/passport#filecredentials
+type FileCredentials struct {
+	FileHash string `json:"file_hash"`
+	Secret   string `json:"secret"`
+}
+
+// DecryptCredentials decrypts the credentials sent with the passport data
+// using the bot's private key. The returned credentials hold the secrets
+// needed to decrypt every shared element and file.
+func DecryptCredentials(key *rsa.PrivateKey, credentials *types.EncryptedCredentials) (*Credentials, error) {
+	secret, err := decryptSecret(key, credentials.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := decryptBase64(credentials.Data, credentials.Hash, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Credentials{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, fmt.Errorf("passport: failed to parse credentials: %w", err)
+	}
+
+	return result, nil
+}
+
+// Value returns the credentials for the element of the given type, or nil
+// if the user did not share it.
+func (s *SecureData) Value(elementType string) *SecureValue {
+	switch elementType {
+	case ElementTypePersonalDetails:
+		return s.PersonalDetails
+	case ElementTypePassport:
+		return s.Passport
+	case ElementTypeInternalPassport:
+		return s.InternalPassport
+	case ElementTypeDriverLicense:
+		return s.DriverLicense
+	case ElementTypeIdentityCard:
+		return s.IdentityCard
+	case ElementTypeAddress:
+		return s.Address
+	case ElementTypeUtilityBill:
+		return s.UtilityBill
+	case ElementTypeBankStatement:
+		return s.BankStatement
+	case ElementTypeRentalAgreement:
+		return s.RentalAgreement
+	case ElementTypePassportRegistration:
+		return s.PassportRegistration
+	case ElementTypeTemporaryRegistration:
+		return s.TemporaryRegistration
+	}
+
+	return nil
+}
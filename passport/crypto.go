@@ -0,0 +1,116 @@
+package passport
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrHashMismatch is returned when decrypted data does not match its hash
+	ErrHashMismatch = errors.New("passport: decrypted data does not match its hash")
+
+	// ErrInvalidPadding is returned when decrypted data has a malformed padding prefix
+	ErrInvalidPadding = errors.New("passport: invalid data padding")
+
+	// ErrMissingCredentials is returned when no credentials are found for an element or file
+	ErrMissingCredentials = errors.New("passport: missing credentials")
+)
+
+// ParsePrivateKey parses a PEM encoded RSA private key in PKCS #1 or PKCS #8
+// form, as generated for the bot's Telegram Passport public key.
+func ParsePrivateKey(pemData []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, errors.New("passport: no PEM data found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("passport: failed to parse private key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("passport: private key is not an RSA key")
+	}
+
+	return rsaKey, nil
+}
+
+// decryptSecret decrypts the base64 encoded credentials secret with the bot's
+// private key using RSA-OAEP with SHA-1.
+func decryptSecret(key *rsa.PrivateKey, secret string) ([]byte, error) {
+	encrypted, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("passport: failed to decode secret: %w", err)
+	}
+
+	decrypted, err := rsa.DecryptOAEP(sha1.New(), nil, key, encrypted, nil)
+	if err != nil {
+		return nil, fmt.Errorf("passport: failed to decrypt secret: %w", err)
+	}
+
+	return decrypted, nil
+}
+
+// decrypt decrypts data with AES-256-CBC using the key and IV derived from
+// SHA512(secret + hash), verifies SHA256 of the result against hash and
+// strips the random padding prefix.
+func decrypt(data, secret, hash []byte) ([]byte, error) {
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("passport: encrypted data length %d is not a multiple of the block size", len(data))
+	}
+
+	digest := sha512.Sum512(append(append([]byte{}, secret...), hash...))
+	key, iv := digest[:32], digest[32:48]
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("passport: %w", err)
+	}
+
+	decrypted := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, data)
+
+	sum := sha256.Sum256(decrypted)
+	if !bytes.Equal(sum[:], hash) {
+		return nil, ErrHashMismatch
+	}
+
+	padding := int(decrypted[0])
+	if padding < 32 || padding > len(decrypted) {
+		return nil, ErrInvalidPadding
+	}
+
+	return decrypted[padding:], nil
+}
+
+// decryptBase64 is decrypt for data and hash that are transferred base64
+// encoded.
+func decryptBase64(data, hash string, secret []byte) ([]byte, error) {
+	rawData, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("passport: failed to decode data: %w", err)
+	}
+
+	rawHash, err := base64.StdEncoding.DecodeString(hash)
+	if err != nil {
+		return nil, fmt.Errorf("passport: failed to decode hash: %w", err)
+	}
+
+	return decrypt(rawData, secret, rawHash)
+}
@@ -0,0 +1,271 @@
+// Package passport decrypts Telegram Passport data shared with the bot.
+//
+// Example:
+//
+//	key, err := passport.ParsePrivateKey(pemData)
+//	if err != nil {
+//		// Do smth
+//	}
+//
+//	data, err := passport.Decrypt(key, msg.PassportData)
+//	if err != nil {
+//		// Do smth
+//	}
+//
+//	for _, element := range data.Elements {
+//		if element.PersonalDetails != nil {
+//			fmt.Println(element.PersonalDetails.FirstName)
+//		}
+//	}
+package passport
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/OhMyDitzzy/gramgo"
+	"github.com/OhMyDitzzy/gramgo/types"
+)
+
+// Telegram Passport element types
+const (
+	ElementTypePersonalDetails       = "personal_details"
+	ElementTypePassport              = "passport"
+	ElementTypeDriverLicense         = "driver_license"
+	ElementTypeIdentityCard          = "identity_card"
+	ElementTypeInternalPassport      = "internal_passport"
+	ElementTypeAddress               = "address"
+	ElementTypeUtilityBill           = "utility_bill"
+	ElementTypeBankStatement         = "bank_statement"
+	ElementTypeRentalAgreement       = "rental_agreement"
+	ElementTypePassportRegistration  = "passport_registration"
+	ElementTypeTemporaryRegistration = "temporary_registration"
+	ElementTypePhoneNumber           = "phone_number"
+	ElementTypeEmail                 = "email"
+)
+
+// PersonalDetails https://core.telegram.org/passport#personaldetails
+type PersonalDetails struct {
+	FirstName            string `json:"first_name"`
+	LastName             string `json:"last_name"`
+	MiddleName           string `json:"middle_name,omitempty"`
+	BirthDate            string `json:"birth_date"`
+	Gender               string `json:"gender"`
+	CountryCode          string `json:"country_code"`
+	ResidenceCountryCode string `json:"residence_country_code"`
+	FirstNameNative      string `json:"first_name_native"`
+	LastNameNative       string `json:"last_name_native"`
+	MiddleNameNative     string `json:"middle_name_native,omitempty"`
+}
+
+// IDDocumentData https://core.telegram.org/passport#iddocumentdata
+type IDDocumentData struct {
+	DocumentNo string `json:"document_no"`
+	ExpiryDate string `json:"expiry_date,omitempty"`
+}
+
+// ResidentialAddress https://core.telegram.org/passport#residentialaddress
+type ResidentialAddress struct {
+	StreetLine1 string `json:"street_line1"`
+	StreetLine2 string `json:"street_line2,omitempty"`
+	City        string `json:"city"`
+	State       string `json:"state,omitempty"`
+	CountryCode string `json:"country_code"`
+	PostCode    string `json:"post_code"`
+}
+
+// Passport holds the decrypted passport data
+type Passport struct {
+	Elements    []Element
+	Credentials *Credentials
+}
+
+// Element is a decrypted types.EncryptedPassportElement. Only the data field
+// matching Type is set.
+type Element struct {
+	Type            string
+	PersonalDetails *PersonalDetails
+	IDDocument      *IDDocumentData
+	Address         *ResidentialAddress
+	PhoneNumber     string
+	Email           string
+	FrontSide       *File
+	ReverseSide     *File
+	Selfie          *File
+	Translation     []File
+	Files           []File
+	Hash            string // Element hash, used for PassportElementErrorUnspecified
+}
+
+// File is a passport file together with the credentials needed to decrypt
+// its content. The content itself has to be downloaded first.
+type File struct {
+	types.PassportFile
+	Credentials FileCredentials
+}
+
+// Decrypt decrypts the credentials and the data of every element shared by
+// the user. Files are not downloaded, use File.Download for that.
+func Decrypt(key *rsa.PrivateKey, data *types.PassportData) (*Passport, error) {
+	credentials, err := DecryptCredentials(key, &data.Credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Passport{
+		Elements:    make([]Element, 0, len(data.Data)),
+		Credentials: credentials,
+	}
+
+	for i := range data.Data {
+		element, err := credentials.DecryptElement(&data.Data[i])
+		if err != nil {
+			return nil, err
+		}
+		result.Elements = append(result.Elements, *element)
+	}
+
+	return result, nil
+}
+
+// DecryptElement decrypts the data of a single element and attaches the
+// credentials to its files.
+func (c *Credentials) DecryptElement(encrypted *types.EncryptedPassportElement) (*Element, error) {
+	element := &Element{
+		Type:        encrypted.Type,
+		PhoneNumber: encrypted.PhoneNumber,
+		Email:       encrypted.Email,
+		Hash:        encrypted.Hash,
+	}
+
+	if encrypted.Type == ElementTypePhoneNumber || encrypted.Type == ElementTypeEmail {
+		return element, nil
+	}
+
+	value := c.SecureData.Value(encrypted.Type)
+	if value == nil {
+		return nil, fmt.Errorf("%w for %s", ErrMissingCredentials, encrypted.Type)
+	}
+
+	if encrypted.Data != "" {
+		if err := decryptElementData(element, encrypted, value.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	if element.FrontSide, err = attachCredentials(encrypted.FrontSide, value.FrontSide, encrypted.Type, "front side"); err != nil {
+		return nil, err
+	}
+	if element.ReverseSide, err = attachCredentials(encrypted.ReverseSide, value.ReverseSide, encrypted.Type, "reverse side"); err != nil {
+		return nil, err
+	}
+	if element.Selfie, err = attachCredentials(encrypted.Selfie, value.Selfie, encrypted.Type, "selfie"); err != nil {
+		return nil, err
+	}
+	if element.Files, err = attachFileCredentials(encrypted.Files, value.Files, encrypted.Type, "files"); err != nil {
+		return nil, err
+	}
+	if element.Translation, err = attachFileCredentials(encrypted.Translation, value.Translation, encrypted.Type, "translation"); err != nil {
+		return nil, err
+	}
+
+	return element, nil
+}
+
+// Decrypt decrypts the downloaded content of the file and verifies it
+// against the file hash.
+func (f *File) Decrypt(content []byte) ([]byte, error) {
+	secret, err := base64.StdEncoding.DecodeString(f.Credentials.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("passport: failed to decode file secret: %w", err)
+	}
+
+	hash, err := base64.StdEncoding.DecodeString(f.Credentials.FileHash)
+	if err != nil {
+		return nil, fmt.Errorf("passport: failed to decode file hash: %w", err)
+	}
+
+	return decrypt(content, secret, hash)
+}
+
+// Download downloads the file through the bot and returns its decrypted
+// content.
+func (f *File) Download(ctx context.Context, bot *gramgo.GramGoBot) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := bot.DownloadFile(ctx, f.FileID, &buf); err != nil {
+		return nil, err
+	}
+
+	return f.Decrypt(buf.Bytes())
+}
+
+func decryptElementData(element *Element, encrypted *types.EncryptedPassportElement, credentials *DataCredentials) error {
+	if credentials == nil {
+		return fmt.Errorf("%w for %s data", ErrMissingCredentials, encrypted.Type)
+	}
+
+	secret, err := base64.StdEncoding.DecodeString(credentials.Secret)
+	if err != nil {
+		return fmt.Errorf("passport: failed to decode %s secret: %w", encrypted.Type, err)
+	}
+
+	data, err := decryptBase64(encrypted.Data, credentials.DataHash, secret)
+	if err != nil {
+		return fmt.Errorf("passport: failed to decrypt %s: %w", encrypted.Type, err)
+	}
+
+	var target any
+	switch encrypted.Type {
+	case ElementTypePersonalDetails:
+		element.PersonalDetails = &PersonalDetails{}
+		target = element.PersonalDetails
+	case ElementTypePassport, ElementTypeDriverLicense, ElementTypeIdentityCard, ElementTypeInternalPassport:
+		element.IDDocument = &IDDocumentData{}
+		target = element.IDDocument
+	case ElementTypeAddress:
+		element.Address = &ResidentialAddress{}
+		target = element.Address
+	default:
+		return nil
+	}
+
+	if err := json.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("passport: failed to parse %s: %w", encrypted.Type, err)
+	}
+
+	return nil
+}
+
+func attachCredentials(file *types.PassportFile, credentials *FileCredentials, elementType, name string) (*File, error) {
+	if file == nil {
+		return nil, nil
+	}
+
+	if credentials == nil {
+		return nil, fmt.Errorf("%w for %s %s", ErrMissingCredentials, elementType, name)
+	}
+
+	return &File{PassportFile: *file, Credentials: *credentials}, nil
+}
+
+func attachFileCredentials(files []types.PassportFile, credentials []FileCredentials, elementType, name string) ([]File, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	if len(credentials) != len(files) {
+		return nil, fmt.Errorf("%w for %s %s", ErrMissingCredentials, elementType, name)
+	}
+
+	result := make([]File, len(files))
+	for i := range files {
+		result[i] = File{PassportFile: files[i], Credentials: credentials[i]}
+	}
+
+	return result, nil
+}
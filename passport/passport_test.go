@@ -0,0 +1,159 @@
+package passport
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/OhMyDitzzy/gramgo/types"
+)
+
+// encrypt mirrors the Telegram Passport encryption scheme
+func encrypt(t *testing.T, plain []byte) (data, secret, hash []byte) {
+	t.Helper()
+
+	padding := 32 + (16-(len(plain)+32)%16)%16
+	padded := make([]byte, padding+len(plain))
+	if _, err := rand.Read(padded[:padding]); err != nil {
+		t.Fatal(err)
+	}
+	padded[0] = byte(padding)
+	copy(padded[padding:], plain)
+
+	sum := sha256.Sum256(padded)
+	hash = sum[:]
+
+	secret = make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha512.Sum512(append(append([]byte{}, secret...), hash...))
+	block, err := aes.NewCipher(digest[:32])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data = make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, digest[32:48]).CryptBlocks(data, padded)
+
+	return data, secret, hash
+}
+
+func b64(b []byte) string {
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func TestDecrypt(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	details, _ := json.Marshal(PersonalDetails{FirstName: "John", LastName: "Doe"})
+	detailsData, detailsSecret, detailsHash := encrypt(t, details)
+
+	document, _ := json.Marshal(IDDocumentData{DocumentNo: "AB123"})
+	documentData, documentSecret, documentHash := encrypt(t, document)
+
+	scan := []byte("front side scan")
+	scanData, scanSecret, scanHash := encrypt(t, scan)
+
+	credentials, _ := json.Marshal(Credentials{
+		SecureData: SecureData{
+			PersonalDetails: &SecureValue{
+				Data: &DataCredentials{DataHash: b64(detailsHash), Secret: b64(detailsSecret)},
+			},
+			Passport: &SecureValue{
+				Data:      &DataCredentials{DataHash: b64(documentHash), Secret: b64(documentSecret)},
+				FrontSide: &FileCredentials{FileHash: b64(scanHash), Secret: b64(scanSecret)},
+			},
+		},
+		Nonce: "nonce",
+	})
+	credentialsData, credentialsSecret, credentialsHash := encrypt(t, credentials)
+
+	encryptedSecret, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, &key.PublicKey, credentialsSecret, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := &types.PassportData{
+		Data: []types.EncryptedPassportElement{
+			{Type: ElementTypePersonalDetails, Data: b64(detailsData), Hash: "h1"},
+			{Type: ElementTypePassport, Data: b64(documentData), FrontSide: &types.PassportFile{FileID: "front"}, Hash: "h2"},
+			{Type: ElementTypeEmail, Email: "john@example.com", Hash: "h3"},
+		},
+		Credentials: types.EncryptedCredentials{
+			Data:   b64(credentialsData),
+			Hash:   b64(credentialsHash),
+			Secret: b64(encryptedSecret),
+		},
+	}
+
+	result, err := Decrypt(key, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Credentials.Nonce != "nonce" {
+		t.Fatal("wrong nonce")
+	}
+
+	if len(result.Elements) != 3 {
+		t.Fatal("wrong elements length")
+	}
+
+	if result.Elements[0].PersonalDetails == nil || result.Elements[0].PersonalDetails.FirstName != "John" {
+		t.Fatal("wrong personal details")
+	}
+
+	passport := result.Elements[1]
+	if passport.IDDocument == nil || passport.IDDocument.DocumentNo != "AB123" {
+		t.Fatal("wrong id document")
+	}
+
+	if passport.FrontSide == nil || passport.FrontSide.FileID != "front" {
+		t.Fatal("wrong front side")
+	}
+
+	content, err := passport.FrontSide.Decrypt(scanData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(content) != string(scan) {
+		t.Fatal("wrong front side content")
+	}
+
+	if result.Elements[2].Email != "john@example.com" {
+		t.Fatal("wrong email")
+	}
+}
+
+func TestFile_Decrypt_hashMismatch(t *testing.T) {
+	data, secret, hash := encrypt(t, []byte("content"))
+	data[len(data)-1] ^= 0xff
+
+	file := &File{Credentials: FileCredentials{FileHash: b64(hash), Secret: b64(secret)}}
+	if _, err := file.Decrypt(data); !errors.Is(err, ErrHashMismatch) {
+		t.Fatal("expected hash mismatch, got", err)
+	}
+}
+
+func TestCredentials_DecryptElement_missingCredentials(t *testing.T) {
+	credentials := &Credentials{}
+
+	_, err := credentials.DecryptElement(&types.EncryptedPassportElement{Type: ElementTypeAddress, Data: "x"})
+	if !errors.Is(err, ErrMissingCredentials) {
+		t.Fatal("expected missing credentials, got", err)
+	}
+}
@@ -110,6 +110,8 @@ func (b *GramGoBot) dropPendingUpdates(ctx context.Context) error {
 }
 
 func (b *GramGoBot) handleUpdate(ctx context.Context, update *types.Update) {
+	b.handleChatMigration(ctx, update)
+
 	updateCtx := newContext(ctx, b, update)
 
 	handler := HandlerFunc(b.routeUpdate)
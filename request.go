@@ -6,22 +6,98 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"mime/multipart"
 	"net/http"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/OhMyDitzzy/gramgo/types"
 )
 
 func (b *GramGoBot) rawRequest(ctx context.Context, method string, params any, result any) error {
-	url := b.apiURL + "/" + method
+	request := RequestFunc(b.executeRequest)
+	for i := len(b.interceptors) - 1; i >= 0; i-- {
+		request = b.interceptors[i](request)
+	}
+
+	return request(ctx, method, params, result)
+}
+
+// executeRequest sends the request and follows chat migrations. It is the
+// innermost RequestFunc of the interceptor chain.
+//
+// Uploads are buffered in memory when the request may have to be sent more
+// than once, because of retries or a chat migration.
+func (b *GramGoBot) executeRequest(ctx context.Context, method string, params any, result any) error {
+	var body *bufferedBody
+	if shouldUseMultipart(params) && (b.retry.MaxAttempts > 1 || b.followMigration) {
+		var err error
+		body, err = b.bufferMultipartBody(params)
+		if err != nil {
+			return fmt.Errorf("failed to build request for %s: %w", method, err)
+		}
+	}
 
-	req, err := b.buildRequest(ctx, url, params)
+	err := b.sendRequest(ctx, method, params, body, result)
 	if err != nil {
-		return fmt.Errorf("failed to build request for %s: %w", method, err)
+		return b.followChatMigration(ctx, method, params, body, result, err)
+	}
+
+	return nil
+}
+
+// sendRequest sends a request, retrying it as configured by Config.Retry.
+// Every attempt waits for its own slot from the scheduler, so retries are
+// paced like any other request. When body is not nil, it is sent instead of
+// encoding params.
+func (b *GramGoBot) sendRequest(ctx context.Context, method string, params any, body *bufferedBody, result any) error {
+	url := b.apiURL + "/" + method
+
+	newRequest := func() (*http.Request, error) {
+		if body != nil {
+			return body.newRequest(ctx, url)
+		}
+		return b.buildRequest(ctx, url, params)
 	}
 
+	for attempt := 1; ; attempt++ {
+		if b.scheduler != nil {
+			if err := b.scheduler.wait(ctx, method, params); err != nil {
+				return fmt.Errorf("failed to schedule request for %s: %w", method, err)
+			}
+		}
+
+		req, err := newRequest()
+		if err != nil {
+			return fmt.Errorf("failed to build request for %s: %w", method, err)
+		}
+
+		err = b.doRequest(req, method, result)
+		if err == nil || attempt >= b.retry.MaxAttempts || ctx.Err() != nil {
+			return err
+		}
+
+		delay, ok := b.retry.delay(method, attempt, err)
+		if !ok {
+			return err
+		}
+
+		log.Printf("[gramgo] %s failed (attempt %d/%d), retrying in %v: %v", method, attempt, b.retry.MaxAttempts, delay, err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// doRequest sends req and decodes the API response into result.
+func (b *GramGoBot) doRequest(req *http.Request, method string, result any) error {
 	resp, err := b.client.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to execute request for %s: %w", method, err)
@@ -39,6 +115,14 @@ func (b *GramGoBot) rawRequest(ctx context.Context, method string, params any, r
 
 	var apiResp types.APIResponse
 	if err := json.Unmarshal(body, &apiResp); err != nil {
+		// Proxies and gateways in front of the API answer errors such as
+		// 502 Bad Gateway with an HTML page
+		if resp.StatusCode >= http.StatusBadRequest {
+			return b.handleAPIError(method, &types.APIResponse{
+				ErrorCode:   resp.StatusCode,
+				Description: http.StatusText(resp.StatusCode),
+			})
+		}
 		return fmt.Errorf("failed to parse response for %s: %w (body: %s)", method, err, body)
 	}
 
@@ -171,6 +255,10 @@ func (b *GramGoBot) writeFormField(writer *multipart.Writer, fieldName string, f
 		}
 	}
 
+	if isInputMediaField(field) {
+		return b.writeInputMedia(writer, fieldName, field)
+	}
+
 	switch field.Kind() {
 	case reflect.String:
 		return writer.WriteField(fieldName, field.String())
@@ -192,7 +280,7 @@ func (b *GramGoBot) writeFormField(writer *multipart.Writer, fieldName string, f
 		if err != nil {
 			return fmt.Errorf("failed to marshal field: %w", err)
 		}
-		
+
 		data = bytes.Trim(data, "\"")
 		return writer.WriteField(fieldName, string(data))
 
@@ -218,6 +306,184 @@ func (b *GramGoBot) writeFileUpload(writer *multipart.Writer, fieldName string,
 	return nil
 }
 
+// inputMedia is implemented by every media object that is sent as a JSON
+// description and may carry its own attachment, such as types.InputMedia
+// and types.InputPaidMedia.
+type inputMedia interface {
+	MarshalInputMedia() ([]byte, error)
+	Attachment() io.Reader
+	GetMedia() string
+}
+
+var inputMediaType = reflect.TypeFor[inputMedia]()
+
+const attachPrefix = "attach://"
+
+// isInputMediaField reports whether field holds a single media object or a
+// slice of them.
+func isInputMediaField(field reflect.Value) bool {
+	switch field.Kind() {
+	case reflect.Slice, reflect.Array:
+		return field.Type().Elem().Implements(inputMediaType)
+	case reflect.Interface, reflect.Ptr:
+		if field.IsNil() {
+			return false
+		}
+		_, ok := field.Interface().(inputMedia)
+		return ok
+	}
+
+	_, ok := field.Interface().(inputMedia)
+	return ok
+}
+
+// writeInputMedia writes the JSON description of the media in field under
+// fieldName and uploads every attachment as its own part.
+//
+// A media object references its MediaAttachment as "attach://<name>" in its
+// media field, and nested uploads such as thumbnails are set as
+// *types.InputFileUpload. Since names chosen by the caller may repeat across
+// the items, every reference is replaced by a name that is unique within the
+// request (<fieldName>0, <fieldName>1, ...), and the part is written under
+// that name. The caller's name, or InputFileUpload.Filename, is only kept as
+// the filename of the part.
+func (b *GramGoBot) writeInputMedia(writer *multipart.Writer, fieldName string, field reflect.Value) error {
+	var items []inputMedia
+	isList := field.Kind() == reflect.Slice || field.Kind() == reflect.Array
+	if isList {
+		for i := 0; i < field.Len(); i++ {
+			item, ok := field.Index(i).Interface().(inputMedia)
+			if !ok || item == nil {
+				return fmt.Errorf("media #%d is nil", i)
+			}
+			items = append(items, item)
+		}
+	} else {
+		items = append(items, field.Interface().(inputMedia))
+	}
+
+	attachments := 0
+	nextAttachName := func() string {
+		name := fmt.Sprintf("%s%d", fieldName, attachments)
+		attachments++
+		return name
+	}
+
+	lines := make([]string, 0, len(items))
+	for i, item := range items {
+		data, err := item.MarshalInputMedia()
+		if err != nil {
+			return fmt.Errorf("failed to marshal media #%d: %w", i, err)
+		}
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return fmt.Errorf("failed to marshal media #%d: %w", i, err)
+		}
+
+		if attachment := item.Attachment(); attachment != nil {
+			filename, ok := strings.CutPrefix(item.GetMedia(), attachPrefix)
+			if !ok || filename == "" {
+				return fmt.Errorf("media #%d has an attachment but does not reference it as %s<name>", i, attachPrefix)
+			}
+
+			name := nextAttachName()
+			if err := replaceAttachReference(fields, item.GetMedia(), name); err != nil {
+				return fmt.Errorf("media #%d: %w", i, err)
+			}
+
+			if err := b.writeFileUpload(writer, name, &types.InputFileUpload{
+				Filename: filename,
+				Data:     attachment,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err := b.writeNestedUploads(writer, reflect.ValueOf(item), fields, nextAttachName); err != nil {
+			return fmt.Errorf("failed to write attachments of media #%d: %w", i, err)
+		}
+
+		data, err = json.Marshal(fields)
+		if err != nil {
+			return fmt.Errorf("failed to marshal media #%d: %w", i, err)
+		}
+		lines = append(lines, string(data))
+	}
+
+	if isList {
+		return writer.WriteField(fieldName, "["+strings.Join(lines, ",")+"]")
+	}
+	return writer.WriteField(fieldName, lines[0])
+}
+
+// replaceAttachReference replaces the field of a marshalled media object that
+// holds reference with attach://<name>.
+func replaceAttachReference(fields map[string]json.RawMessage, reference, name string) error {
+	value, err := json.Marshal(attachPrefix + name)
+	if err != nil {
+		return err
+	}
+
+	for key, raw := range fields {
+		var s string
+		if json.Unmarshal(raw, &s) == nil && s == reference {
+			fields[key] = value
+			return nil
+		}
+	}
+
+	return fmt.Errorf("attachment reference %s not found", reference)
+}
+
+// writeNestedUploads uploads the *types.InputFileUpload fields of a media
+// object, such as thumbnails, under a name from nextAttachName and points the
+// matching field of the marshalled object to it.
+func (b *GramGoBot) writeNestedUploads(writer *multipart.Writer, v reflect.Value, fields map[string]json.RawMessage, nextAttachName func() string) error {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	t := v.Type()
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if !field.CanInterface() || field.Kind() != reflect.Interface || field.IsNil() {
+			continue
+		}
+
+		fileUpload, ok := field.Interface().(*types.InputFileUpload)
+		if !ok {
+			continue
+		}
+
+		key, _ := parseJSONTag(t.Field(i).Tag.Get("json"))
+		if key == "" || key == "-" {
+			continue
+		}
+
+		name := nextAttachName()
+		value, err := json.Marshal(attachPrefix + name)
+		if err != nil {
+			return err
+		}
+		fields[key] = value
+
+		if err := b.writeFileUpload(writer, name, fileUpload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func shouldUseMultipart(params any) bool {
 	if params == nil {
 		return false
@@ -237,7 +503,7 @@ func shouldUseMultipart(params any) bool {
 
 	for i := 0; i < v.NumField(); i++ {
 		field := v.Field(i)
-		
+
 		if !field.CanInterface() {
 			continue
 		}
@@ -256,6 +522,13 @@ func shouldUseMultipart(params any) bool {
 				}
 			}
 		}
+
+		// Media objects carry a "type" discriminator that only their
+		// MarshalInputMedia method emits, so they are always sent as form
+		// fields.
+		if isInputMediaField(field) {
+			return true
+		}
 	}
 
 	return false
@@ -263,9 +536,12 @@ func shouldUseMultipart(params any) bool {
 
 func (b *GramGoBot) handleAPIError(method string, resp *types.APIResponse) error {
 	baseErr := &APIError{
-		Code:        resp.ErrorCode,
-		Description: resp.Description,
-		Parameters:  resp.Parameters,
+		Method:         method,
+		Code:           resp.ErrorCode,
+		Description:    resp.Description,
+		RawDescription: resp.Description,
+		Parameters:     resp.Parameters,
+		Err:            classifyAPIError(resp.ErrorCode, resp.Description),
 	}
 
 	switch resp.ErrorCode {
@@ -291,14 +567,14 @@ func (b *GramGoBot) handleAPIError(method string, resp *types.APIResponse) error
 func parseJSONTag(tag string) (name string, omitEmpty bool) {
 	parts := strings.Split(tag, ",")
 	name = parts[0]
-	
+
 	for i := 1; i < len(parts); i++ {
 		if parts[i] == "omitempty" {
 			omitEmpty = true
 			break
 		}
 	}
-	
+
 	return name, omitEmpty
 }
 
@@ -306,7 +582,7 @@ func isZeroValue(v reflect.Value) bool {
 	if !v.IsValid() {
 		return true
 	}
-	
+
 	switch v.Kind() {
 	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
 		return v.Len() == 0
@@ -321,7 +597,7 @@ func isZeroValue(v reflect.Value) bool {
 	case reflect.Interface, reflect.Ptr:
 		return v.IsNil()
 	}
-	
+
 	return false
 }
 
@@ -329,7 +605,7 @@ func isNilOrEmpty(i any) bool {
 	if i == nil {
 		return true
 	}
-	
+
 	v := reflect.ValueOf(i)
 	switch v.Kind() {
 	case reflect.Ptr, reflect.Interface:
@@ -337,6 +613,6 @@ func isNilOrEmpty(i any) bool {
 	case reflect.Slice, reflect.Map:
 		return v.Len() == 0
 	}
-	
+
 	return false
-}
\ No newline at end of file
+}
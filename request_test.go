@@ -0,0 +1,156 @@
+package gramgo
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/OhMyDitzzy/gramgo/types"
+)
+
+func TestSendMediaGroup_attachments(t *testing.T) {
+	type upload struct {
+		filename string
+		content  string
+	}
+
+	var media []map[string]any
+	uploads := map[string]upload{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reader, err := r.MultipartReader()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			data, _ := io.ReadAll(part)
+			if part.FormName() == "media" {
+				if err := json.Unmarshal(data, &media); err != nil {
+					t.Error(err)
+				}
+				continue
+			}
+
+			if part.FileName() == "" {
+				continue
+			}
+
+			if _, ok := uploads[part.FormName()]; ok {
+				t.Errorf("part %s is sent twice", part.FormName())
+			}
+			uploads[part.FormName()] = upload{part.FileName(), string(data)}
+		}
+
+		w.Write([]byte(`{"ok":true,"result":[]}`))
+	}))
+	defer srv.Close()
+
+	bot, err := NewBot(Config{Token: "T", APIBaseURL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Both videos reuse the same names, and the second thumbnail's name
+	// needs escaping.
+	_, err = bot.SendMediaGroup(context.Background(), &types.SendMediaGroupParams{
+		ChatID: 1,
+		Media: []types.InputMedia{
+			&types.InputMediaVideo{
+				Media:           "attach://clip",
+				MediaAttachment: strings.NewReader("video 1"),
+				Thumbnail:       &types.InputFileUpload{Filename: "thumb.jpg", Data: strings.NewReader("thumb 1")},
+			},
+			&types.InputMediaVideo{
+				Media:           "attach://clip",
+				MediaAttachment: strings.NewReader("video 2"),
+				Thumbnail:       &types.InputFileUpload{Filename: `th"umb\.jpg`, Data: strings.NewReader("thumb 2")},
+			},
+			&types.InputMediaPhoto{
+				Media: "file-id",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(media) != 3 {
+		t.Fatal("wrong media length")
+	}
+
+	part := func(reference any) upload {
+		name, ok := strings.CutPrefix(reference.(string), "attach://")
+		if !ok {
+			t.Fatal("not an attach reference:", reference)
+		}
+		return uploads[name]
+	}
+
+	for i, want := range []struct {
+		video, thumb, thumbFilename string
+	}{
+		{"video 1", "thumb 1", "thumb.jpg"},
+		{"video 2", "thumb 2", `th"umb\.jpg`},
+	} {
+		if media[i]["type"] != "video" {
+			t.Fatal("wrong type of media", i)
+		}
+
+		if got := part(media[i]["media"]); got.content != want.video || got.filename != "clip" {
+			t.Fatal("wrong video of media", i, got)
+		}
+
+		if got := part(media[i]["thumbnail"]); got.content != want.thumb || got.filename != want.thumbFilename {
+			t.Fatal("wrong thumbnail of media", i, got)
+		}
+	}
+
+	if media[2]["media"] != "file-id" {
+		t.Fatal("wrong photo media")
+	}
+
+	if len(uploads) != 4 {
+		t.Fatal("wrong uploads count", len(uploads))
+	}
+}
+
+func TestSendMediaGroup_attachmentWithoutReference(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		w.Write([]byte(`{"ok":true,"result":[]}`))
+	}))
+	defer srv.Close()
+
+	bot, err := NewBot(Config{Token: "T", APIBaseURL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = bot.SendMediaGroup(context.Background(), &types.SendMediaGroupParams{
+		ChatID: 1,
+		Media: []types.InputMedia{
+			&types.InputMediaPhoto{
+				Media:           "photo.jpg",
+				MediaAttachment: strings.NewReader("photo"),
+			},
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "attach://") {
+		t.Fatal("expected missing attach:// reference error, got", err)
+	}
+}
@@ -0,0 +1,134 @@
+package gramgo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RetryConfig holds the retry policy for API requests
+//
+// Requests failing with 429 Too Many Requests or a 5xx error are retried
+// for every method. Network errors are retried only for get* methods and the
+// few other methods that answer True when repeated, such as setWebhook or
+// setMyCommands. A request like deleteMessage may have reached Telegram
+// before the connection broke, and sending it again would report the success
+// as a failure.
+//
+// When retries are enabled, multipart bodies are buffered in memory so
+// uploads can be sent again.
+type RetryConfig struct {
+	MaxAttempts    int           // Maximum attempts including the first one (default: 1, no retries)
+	InitialBackoff time.Duration // Backoff before the first retry, doubled on every retry (default: 500ms)
+	MaxBackoff     time.Duration // Maximum backoff between retries (default: 30s)
+	MaxRetryAfter  time.Duration // Give up when RetryAfter asks to wait longer than this (default: no limit)
+}
+
+func (c RetryConfig) withDefaults() RetryConfig {
+	if c.MaxAttempts < 1 {
+		c.MaxAttempts = 1
+	}
+	if c.InitialBackoff <= 0 {
+		c.InitialBackoff = 500 * time.Millisecond
+	}
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = 30 * time.Second
+	}
+	return c
+}
+
+// delay returns how long to wait before retrying a failed attempt, or false
+// when err should not be retried.
+func (c RetryConfig) delay(method string, attempt int, err error) (time.Duration, bool) {
+	if retryAfter := GetRetryAfter(err); retryAfter > 0 {
+		wait := time.Duration(retryAfter) * time.Second
+		if c.MaxRetryAfter > 0 && wait > c.MaxRetryAfter {
+			return 0, false
+		}
+		return wait, true
+	}
+
+	if !IsRetryableError(err) && !(isNetworkError(err) && isIdempotentMethod(method)) {
+		return 0, false
+	}
+
+	backoff := c.InitialBackoff
+	for i := 1; i < attempt && backoff < c.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, c.MaxBackoff)
+
+	// Equal jitter: wait at least half of the backoff so retries from many
+	// goroutines spread out without retrying immediately.
+	half := backoff / 2
+	return half + rand.N(half+1), true
+}
+
+// bufferedBody is a multipart body held in memory.
+type bufferedBody struct {
+	data        []byte
+	contentType string
+}
+
+// bufferMultipartBody writes the whole multipart body into memory, so it can
+// be sent again on retry even if the uploaded readers cannot be rewound.
+func (b *GramGoBot) bufferMultipartBody(params any) (*bufferedBody, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	if err := b.writeFormFields(writer, params); err != nil {
+		return nil, fmt.Errorf("failed to write form fields: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write form fields: %w", err)
+	}
+
+	return &bufferedBody{data: buf.Bytes(), contentType: writer.FormDataContentType()}, nil
+}
+
+// newRequest returns a POST request to url sending the buffered body.
+func (body *bufferedBody) newRequest(ctx context.Context, url string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body.data))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", body.contentType)
+	return req, nil
+}
+
+// idempotentMethods lists the methods besides get* that can be sent twice and
+// still succeed with the same result.
+var idempotentMethods = map[string]bool{
+	"setWebhook":                      true,
+	"deleteWebhook":                   true,
+	"setMyCommands":                   true,
+	"deleteMyCommands":                true,
+	"setMyName":                       true,
+	"setMyDescription":                true,
+	"setMyShortDescription":           true,
+	"setChatMenuButton":               true,
+	"setMyDefaultAdministratorRights": true,
+}
+
+// isIdempotentMethod reports whether sending method twice has the same effect
+// as sending it once.
+func isIdempotentMethod(method string) bool {
+	return strings.HasPrefix(method, "get") || idempotentMethods[method]
+}
+
+func isNetworkError(err error) bool {
+	var urlErr *url.Error
+	var netErr net.Error
+	return errors.As(err, &urlErr) || errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
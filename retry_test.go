@@ -0,0 +1,249 @@
+package gramgo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/OhMyDitzzy/gramgo/types"
+)
+
+func TestRetryConfig_delay_backoff(t *testing.T) {
+	config := RetryConfig{
+		MaxAttempts:    10,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+	}.withDefaults()
+
+	err := &APIError{Code: 502}
+
+	tests := []struct {
+		attempt int
+		backoff time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{50, time.Second},
+	}
+
+	for _, tt := range tests {
+		// Equal jitter keeps the delay between half and all of the backoff
+		for range 100 {
+			delay, ok := config.delay("sendMessage", tt.attempt, err)
+			if !ok {
+				t.Fatal("expected a retry on attempt", tt.attempt)
+			}
+
+			if delay < tt.backoff/2 || delay > tt.backoff {
+				t.Fatalf("attempt %d: delay %v outside [%v, %v]", tt.attempt, delay, tt.backoff/2, tt.backoff)
+			}
+		}
+	}
+}
+
+func TestRetryConfig_delay_retryAfter(t *testing.T) {
+	err := &APIError{
+		Code:       429,
+		Parameters: &types.ResponseParameters{RetryAfter: 5},
+	}
+
+	tests := []struct {
+		maxRetryAfter time.Duration
+		wantDelay     time.Duration
+		wantRetry     bool
+	}{
+		{0, 5 * time.Second, true},
+		{10 * time.Second, 5 * time.Second, true},
+		{5 * time.Second, 5 * time.Second, true},
+		{2 * time.Second, 0, false},
+	}
+
+	for _, tt := range tests {
+		config := RetryConfig{MaxAttempts: 3, MaxRetryAfter: tt.maxRetryAfter}.withDefaults()
+
+		delay, ok := config.delay("sendMessage", 1, err)
+		if ok != tt.wantRetry || delay != tt.wantDelay {
+			t.Fatalf("MaxRetryAfter %v: got (%v, %t), want (%v, %t)", tt.maxRetryAfter, delay, ok, tt.wantDelay, tt.wantRetry)
+		}
+	}
+}
+
+func TestRetryConfig_delay_classification(t *testing.T) {
+	networkErr := &url.Error{Op: "Post", URL: "https://api.telegram.org", Err: errors.New("connection reset by peer")}
+
+	tests := []struct {
+		name   string
+		method string
+		err    error
+		want   bool
+	}{
+		{"network error on get", "getChat", networkErr, true},
+		{"network error on allowlisted set", "setWebhook", networkErr, true},
+		{"network error on send", "sendMessage", networkErr, false},
+		{"network error on delete", "deleteMessage", networkErr, false},
+		{"network error on set without force", "setGameScore", networkErr, false},
+		{"5xx on send", "sendMessage", &APIError{Code: 502}, true},
+		{"429 without retry after", "sendMessage", &APIError{Code: 429}, true},
+		{"4xx", "getChat", &APIError{Code: 400}, false},
+		{"403", "sendMessage", &APIError{Code: 403}, false},
+		{"other error", "getChat", errors.New("failed to parse response"), false},
+	}
+
+	config := RetryConfig{MaxAttempts: 3}.withDefaults()
+
+	for _, tt := range tests {
+		if _, ok := config.delay(tt.method, 1, tt.err); ok != tt.want {
+			t.Fatalf("%s: got retry %t, want %t", tt.name, ok, tt.want)
+		}
+	}
+}
+
+func TestIsIdempotentMethod(t *testing.T) {
+	tests := []struct {
+		method string
+		want   bool
+	}{
+		{"getMe", true},
+		{"getUpdates", true},
+		{"setWebhook", true},
+		{"deleteWebhook", true},
+		{"setMyCommands", true},
+		{"sendMessage", false},
+		{"deleteMessage", false},
+		{"setGameScore", false},
+		{"setChatTitle", false},
+		{"editMessageText", false},
+	}
+
+	for _, tt := range tests {
+		if got := isIdempotentMethod(tt.method); got != tt.want {
+			t.Fatalf("%s: got %t, want %t", tt.method, got, tt.want)
+		}
+	}
+}
+
+func TestSendRequest_retry_multipart(t *testing.T) {
+	var attempts atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt := attempts.Add(1)
+
+		file, header, err := r.FormFile("document")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		content, _ := io.ReadAll(file)
+		if string(content) != "file content" || header.Filename != "file.txt" || r.FormValue("chat_id") != "1" {
+			t.Errorf("attempt %d: wrong upload %q %q", attempt, header.Filename, content)
+		}
+
+		if attempt == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"ok":false,"error_code":500,"description":"Internal Server Error"}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1,"date":0,"chat":{"id":1,"type":"private"}}}`))
+	}))
+	defer srv.Close()
+
+	bot := newRetryBot(t, srv.URL, time.Millisecond)
+
+	_, err := bot.SendDocument(context.Background(), &types.SendDocumentParams{
+		ChatID:   1,
+		Document: &types.InputFileUpload{Filename: "file.txt", Data: strings.NewReader("file content")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if attempts.Load() != 2 {
+		t.Fatal("wrong attempts", attempts.Load())
+	}
+}
+
+func TestSendRequest_retry_htmlGatewayError(t *testing.T) {
+	var attempts atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>"))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1,"date":0,"chat":{"id":1,"type":"private"}}}`))
+	}))
+	defer srv.Close()
+
+	bot := newRetryBot(t, srv.URL, time.Millisecond)
+
+	if _, err := bot.SendMessage(context.Background(), &types.SendMessageParams{ChatID: 1, Text: "hi"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if attempts.Load() != 3 {
+		t.Fatal("wrong attempts", attempts.Load())
+	}
+}
+
+func TestSendRequest_retry_cancelDuringBackoff(t *testing.T) {
+	var attempts atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("<html>502 Bad Gateway</html>"))
+	}))
+	defer srv.Close()
+
+	bot := newRetryBot(t, srv.URL, 10*time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := bot.SendMessage(ctx, &types.SendMessageParams{ChatID: 1, Text: "hi"})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusBadGateway {
+		t.Fatal("expected the 502 error, got", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatal("backoff was not interrupted, waited", elapsed)
+	}
+
+	if attempts.Load() != 1 {
+		t.Fatal("wrong attempts", attempts.Load())
+	}
+}
+
+func newRetryBot(t *testing.T, apiURL string, backoff time.Duration) *GramGoBot {
+	t.Helper()
+
+	bot, err := NewBot(Config{
+		Token:      "T",
+		APIBaseURL: apiURL,
+		Retry: RetryConfig{
+			MaxAttempts:    3,
+			InitialBackoff: backoff,
+			MaxBackoff:     backoff,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return bot
+}
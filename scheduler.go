@@ -0,0 +1,296 @@
+package gramgo
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SchedulerConfig holds the outbound scheduler configuration
+//
+// The scheduler paces every send*, forward* and copy* request by the
+// params' ChatID so Telegram's broadcast limits are not exceeded. Requests
+// wait in a queue until both the chat and the global limit allow them, and
+// higher priority requests are sent first. Albums sent with sendMediaGroup or
+// sendPaidMedia count as one message per item, and so do the messages of
+// forwardMessages and copyMessages.
+type SchedulerConfig struct {
+	GlobalRate          int           // Maximum requests per second across all chats (default: 30)
+	PrivateChatInterval time.Duration // Minimum interval between requests to a private chat (default: 1s)
+	GroupChatInterval   time.Duration // Minimum interval between requests to a group or channel (default: 3s, 20 per minute)
+}
+
+// Priority is the scheduling priority of a request
+type Priority int
+
+const (
+	PriorityLow Priority = iota - 1 // Bulk sends such as broadcasts
+	PriorityNormal
+	PriorityHigh // Interactive replies
+)
+
+type priorityKey struct{}
+
+// WithPriority returns a context whose requests are scheduled with priority p.
+// Requests without a priority use PriorityNormal.
+//
+// Example:
+//
+//	bot.OnMessage(func(ctx *gramgo.Context) error {
+//		_, err := ctx.Bot.SendMessage(gramgo.WithPriority(ctx, gramgo.PriorityHigh), &types.SendMessageParams{
+//			ChatID: ctx.Update.Message.Chat.ID,
+//			Text:   "Hello",
+//		})
+//		return err
+//	})
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+func priorityFromContext(ctx context.Context) Priority {
+	if p, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return p
+	}
+	return PriorityNormal
+}
+
+type ticket struct {
+	chat     string
+	interval time.Duration
+	messages int
+	priority Priority
+	seq      uint64
+	ready    chan struct{}
+}
+
+type scheduler struct {
+	mu             sync.Mutex
+	config         SchedulerConfig
+	globalInterval time.Duration
+	nextGlobal     time.Time
+	nextChat       map[string]time.Time
+	waiting        []*ticket
+	seq            uint64
+	running        bool
+	wake           chan struct{}
+}
+
+func newScheduler(config SchedulerConfig) *scheduler {
+	if config.GlobalRate <= 0 {
+		config.GlobalRate = 30
+	}
+	if config.PrivateChatInterval <= 0 {
+		config.PrivateChatInterval = time.Second
+	}
+	if config.GroupChatInterval <= 0 {
+		config.GroupChatInterval = 3 * time.Second
+	}
+
+	return &scheduler{
+		config:         config,
+		globalInterval: time.Second / time.Duration(config.GlobalRate),
+		nextChat:       make(map[string]time.Time),
+		wake:           make(chan struct{}, 1),
+	}
+}
+
+// wait blocks until the request for method with params may be sent. Requests
+// that are not sends, or have no ChatID, are not paced.
+func (s *scheduler) wait(ctx context.Context, method string, params any) error {
+	if !isScheduledMethod(method) {
+		return nil
+	}
+
+	chat, private, ok := chatKey(params)
+	if !ok {
+		return nil
+	}
+
+	interval := s.config.GroupChatInterval
+	if private {
+		interval = s.config.PrivateChatInterval
+	}
+
+	s.mu.Lock()
+	s.seq++
+	t := &ticket{
+		chat:     chat,
+		interval: interval,
+		messages: messageCount(method, params),
+		priority: priorityFromContext(ctx),
+		seq:      s.seq,
+		ready:    make(chan struct{}),
+	}
+	s.waiting = append(s.waiting, t)
+	if !s.running {
+		s.running = true
+		go s.run()
+	}
+	s.mu.Unlock()
+	s.notify()
+
+	select {
+	case <-t.ready:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		s.remove(t)
+		s.mu.Unlock()
+		s.notify()
+		return ctx.Err()
+	}
+}
+
+func (s *scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run hands out send slots while requests are waiting.
+func (s *scheduler) run() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		s.mu.Lock()
+		if len(s.waiting) == 0 {
+			s.running = false
+			s.cleanup()
+			s.mu.Unlock()
+			return
+		}
+
+		now := time.Now()
+		delay := s.nextGlobal.Sub(now)
+		if delay <= 0 {
+			var next *ticket
+			delay = -1
+			for _, t := range s.waiting {
+				if wait := s.nextChat[t.chat].Sub(now); wait > 0 {
+					if delay < 0 || wait < delay {
+						delay = wait
+					}
+					continue
+				}
+				if next == nil || t.priority > next.priority || (t.priority == next.priority && t.seq < next.seq) {
+					next = t
+				}
+			}
+
+			if next != nil {
+				s.remove(next)
+				s.nextChat[next.chat] = now.Add(next.interval * time.Duration(next.messages))
+				s.nextGlobal = now.Add(s.globalInterval * time.Duration(next.messages))
+				close(next.ready)
+				s.mu.Unlock()
+				continue
+			}
+		}
+		s.mu.Unlock()
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(delay)
+
+		select {
+		case <-timer.C:
+		case <-s.wake:
+		}
+	}
+}
+
+func (s *scheduler) remove(t *ticket) {
+	for i, w := range s.waiting {
+		if w == t {
+			s.waiting = append(s.waiting[:i], s.waiting[i+1:]...)
+			return
+		}
+	}
+}
+
+// cleanup forgets chats that no longer restrict the next send.
+func (s *scheduler) cleanup() {
+	now := time.Now()
+	for chat, next := range s.nextChat {
+		if !next.After(now) {
+			delete(s.nextChat, chat)
+		}
+	}
+}
+
+func isScheduledMethod(method string) bool {
+	if method == "sendChatAction" {
+		return false
+	}
+
+	return strings.HasPrefix(method, "send") ||
+		strings.HasPrefix(method, "forward") ||
+		strings.HasPrefix(method, "copy")
+}
+
+// messageCount returns how many messages a request posts. Each item of an
+// album and each message of a batch forward or copy is a separate message.
+func messageCount(method string, params any) int {
+	var field string
+	switch method {
+	case "sendMediaGroup", "sendPaidMedia":
+		field = "Media"
+	case "forwardMessages", "copyMessages":
+		field = "MessageIDs"
+	default:
+		return 1
+	}
+
+	v := reflect.ValueOf(params)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return 1
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return 1
+	}
+
+	items := v.FieldByName(field)
+	if items.Kind() != reflect.Slice || items.Len() == 0 {
+		return 1
+	}
+
+	return items.Len()
+}
+
+// chatKey returns the ChatID of params as a map key and whether it refers to
+// a private chat. Private chats have positive IDs, groups and channels have
+// negative IDs or are referenced by @username.
+func chatKey(params any) (string, bool, bool) {
+	field, ok := chatIDField(params)
+	if !ok {
+		return "", false, false
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if field.Int() == 0 {
+			return "", false, false
+		}
+		return fmt.Sprintf("%d", field.Int()), field.Int() > 0, true
+	case reflect.String:
+		if field.String() == "" {
+			return "", false, false
+		}
+		return field.String(), false, true
+	}
+
+	return "", false, false
+}
@@ -0,0 +1,258 @@
+package gramgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OhMyDitzzy/gramgo/types"
+)
+
+// timing tolerance for goroutine wake-ups
+const schedulerSlack = 10 * time.Millisecond
+
+func newTestScheduler() *scheduler {
+	return newScheduler(SchedulerConfig{
+		GlobalRate:          1000,
+		PrivateChatInterval: 30 * time.Millisecond,
+		GroupChatInterval:   60 * time.Millisecond,
+	})
+}
+
+// gap sends first with method, then second with sendMessage, and returns the
+// time the second one waited.
+func gap(t *testing.T, s *scheduler, method string, first, second any) time.Duration {
+	t.Helper()
+
+	if err := s.wait(context.Background(), method, first); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+
+	if err := s.wait(context.Background(), "sendMessage", second); err != nil {
+		t.Fatal(err)
+	}
+
+	return time.Since(start)
+}
+
+func TestScheduler_chatInterval(t *testing.T) {
+	tests := []struct {
+		name   string
+		chatID any
+		want   time.Duration
+	}{
+		{"private chat", int64(42), 30 * time.Millisecond},
+		{"group", int64(-42), 60 * time.Millisecond},
+		{"channel username", "@channel", 60 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestScheduler()
+			params := &types.SendMessageParams{ChatID: tt.chatID, Text: "hi"}
+
+			if got := gap(t, s, "sendMessage", params, params); got < tt.want-schedulerSlack {
+				t.Fatalf("second message after %v, want at least %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduler_otherChatsNotPaced(t *testing.T) {
+	s := newTestScheduler()
+
+	got := gap(t, s, "sendMessage",
+		&types.SendMessageParams{ChatID: int64(-1), Text: "hi"},
+		&types.SendMessageParams{ChatID: int64(-2), Text: "hi"})
+	if got > 20*time.Millisecond {
+		t.Fatalf("message to another chat waited %v", got)
+	}
+}
+
+func TestScheduler_mediaGroupCountsEveryItem(t *testing.T) {
+	s := newTestScheduler()
+
+	album := &types.SendMediaGroupParams{
+		ChatID: int64(42),
+		Media: []types.InputMedia{
+			&types.InputMediaPhoto{Media: "a"},
+			&types.InputMediaPhoto{Media: "b"},
+			&types.InputMediaPhoto{Media: "c"},
+		},
+	}
+
+	got := gap(t, s, "sendMediaGroup", album, &types.SendMessageParams{ChatID: int64(42), Text: "hi"})
+	if want := 90 * time.Millisecond; got < want-schedulerSlack {
+		t.Fatalf("message after album waited %v, want at least %v", got, want)
+	}
+}
+
+func TestScheduler_batchCountsEveryMessage(t *testing.T) {
+	for _, tc := range []struct {
+		method string
+		params any
+	}{
+		{"forwardMessages", &types.ForwardMessagesParams{ChatID: int64(42), FromChatID: int64(7), MessageIDs: []int{1, 2, 3}}},
+		{"copyMessages", &types.CopyMessagesParams{ChatID: int64(42), FromChatID: int64(7), MessageIDs: []int{1, 2, 3}}},
+	} {
+		t.Run(tc.method, func(t *testing.T) {
+			got := gap(t, newTestScheduler(), tc.method, tc.params, &types.SendMessageParams{ChatID: int64(42), Text: "hi"})
+			if want := 90 * time.Millisecond; got < want-schedulerSlack {
+				t.Fatalf("message after %s waited %v, want at least %v", tc.method, got, want)
+			}
+		})
+	}
+}
+
+func TestScheduler_priority(t *testing.T) {
+	s := newTestScheduler()
+	params := &types.SendMessageParams{ChatID: int64(-42), Text: "hi"}
+
+	// Occupy the chat so the next requests queue up
+	if err := s.wait(context.Background(), "sendMessage", params); err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	var order []Priority
+	var wg sync.WaitGroup
+
+	for _, p := range []Priority{PriorityLow, PriorityNormal, PriorityHigh} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.wait(WithPriority(context.Background(), p), "sendMessage", params); err != nil {
+				t.Error(err)
+			}
+			mu.Lock()
+			order = append(order, p)
+			mu.Unlock()
+		}()
+
+		// Queue them in a known order
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	wg.Wait()
+
+	want := []Priority{PriorityHigh, PriorityNormal, PriorityLow}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatal("wrong order", order)
+		}
+	}
+}
+
+func TestScheduler_cancel(t *testing.T) {
+	s := newTestScheduler()
+	params := &types.SendMessageParams{ChatID: int64(-42), Text: "hi"}
+
+	if err := s.wait(context.Background(), "sendMessage", params); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := s.wait(ctx, "sendMessage", params); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatal("expected deadline exceeded, got", err)
+	}
+
+	s.mu.Lock()
+	waiting := len(s.waiting)
+	s.mu.Unlock()
+
+	if waiting != 0 {
+		t.Fatal("cancelled request is still queued")
+	}
+
+	// The cancelled request did not use the chat's next slot
+	start := time.Now()
+	if err := s.wait(context.Background(), "sendMessage", params); err != nil {
+		t.Fatal(err)
+	}
+	if got := time.Since(start); got > 60*time.Millisecond {
+		t.Fatalf("next request waited %v", got)
+	}
+}
+
+func TestScheduler_unscheduled(t *testing.T) {
+	for _, method := range []string{"getChat", "sendChatAction", "editMessageText"} {
+		s := newTestScheduler()
+		params := &types.SendMessageParams{ChatID: int64(42)}
+		got := gap(t, s, method, params, &types.SendMessageParams{ChatID: int64(42)})
+		if got > 20*time.Millisecond {
+			t.Fatalf("%s was paced, next message waited %v", method, got)
+		}
+	}
+}
+
+func TestChatKey(t *testing.T) {
+	tests := []struct {
+		chatID      any
+		wantKey     string
+		wantPrivate bool
+		wantOK      bool
+	}{
+		{int64(42), "42", true, true},
+		{42, "42", true, true},
+		{int64(-1001234), "-1001234", false, true},
+		{"@channel", "@channel", false, true},
+		{int64(0), "", false, false},
+		{"", "", false, false},
+		{nil, "", false, false},
+	}
+
+	for _, tt := range tests {
+		key, private, ok := chatKey(&types.SendMessageParams{ChatID: tt.chatID})
+		if key != tt.wantKey || private != tt.wantPrivate || ok != tt.wantOK {
+			t.Fatalf("%v: got (%q, %t, %t)", tt.chatID, key, private, ok)
+		}
+	}
+}
+
+func TestScheduler_retriesArePaced(t *testing.T) {
+	var mu sync.Mutex
+	var arrivals []time.Time
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		arrivals = append(arrivals, time.Now())
+		attempt := len(arrivals)
+		mu.Unlock()
+
+		if attempt == 1 {
+			w.Write([]byte(`{"ok":false,"error_code":429,"description":"Too Many Requests","parameters":{"retry_after":0}}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1,"date":0,"chat":{"id":42,"type":"private"}}}`))
+	}))
+	defer srv.Close()
+
+	bot, err := NewBot(Config{
+		Token:      "T",
+		APIBaseURL: srv.URL,
+		Retry:      RetryConfig{MaxAttempts: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+		Scheduler:  &SchedulerConfig{GlobalRate: 1000, PrivateChatInterval: 50 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := bot.SendMessage(context.Background(), &types.SendMessageParams{ChatID: int64(42), Text: "hi"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(arrivals) != 2 {
+		t.Fatal("wrong attempts", len(arrivals))
+	}
+
+	if got := arrivals[1].Sub(arrivals[0]); got < 50*time.Millisecond-schedulerSlack {
+		t.Fatalf("retry was sent after %v, before the chat's next slot", got)
+	}
+}
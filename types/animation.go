@@ -12,3 +12,5 @@ type Animation struct {
 	MimeType     string     `json:"mime_type,omitempty"`
 	FileSize     int64      `json:"file_size,omitempty"`
 }
+
+func (a Animation) GetFileID() string { return a.FileID }
@@ -791,7 +791,7 @@ type EditMessageCaptionParams struct {
 	Caption               string                 `json:"caption,omitempty"`
 	ParseMode             ParseMode       `json:"parse_mode,omitempty"`
 	CaptionEntities       []MessageEntity `json:"caption_entities,omitempty"`
-	ShowCaptionAboveMedia bool                   `json:"k,omitempty"`
+	ShowCaptionAboveMedia bool                   `json:"show_caption_above_media,omitempty"`
 	DisableWebPagePreview bool                   `json:"disable_web_page_preview,omitempty"`
 	ReplyMarkup           ReplyMarkup     `json:"reply_markup,omitempty"`
 }
@@ -1067,6 +1067,7 @@ type EditUserStarSubscriptionParams struct {
 	IsCanceled              bool   `json:"is_canceled"`
 }
 
+// SetPassportDataErrorsParams https://core.telegram.org/bots/api#setpassportdataerrors
 type SetPassportDataErrorsParams struct {
 	UserID int64                         `json:"user_id"`
 	Errors []PassportElementError `json:"errors"`
@@ -1077,7 +1078,7 @@ type SendGameParams struct {
 	BusinessConnectionID string                  `json:"business_connection_id,omitempty"`
 	ChatID               any                     `json:"chat_id"`
 	MessageThreadID      int                     `json:"message_thread_id,omitempty"`
-	GameShorName         string                  `json:"game_short_name"`
+	GameShortName        string                  `json:"game_short_name"`
 	DisableNotification  bool                    `json:"disable_notification,omitempty"`
 	ProtectContent       bool                    `json:"protect_content,omitempty"`
 	AllowPaidBroadcast   bool                    `json:"allow_paid_broadcast,omitempty"`
@@ -1087,25 +1088,25 @@ type SendGameParams struct {
 }
 
 type SetGameScoreParams struct {
-	UserID             int64 `json:"user_id"`
-	Score              int   `json:"score"`
-	Force              bool  `json:"force,omitempty"`
-	DisableEditMessage bool  `json:"disable_edit_message,omitempty"`
-	ChatID             any   `json:"chat_id,omitempty"`
-	MessageID          int   `json:"message_id,omitempty"`
-	InlineMessageID    int   `json:"inline_message_id,omitempty"`
+	UserID             int64  `json:"user_id"`
+	Score              int    `json:"score"`
+	Force              bool   `json:"force,omitempty"`
+	DisableEditMessage bool   `json:"disable_edit_message,omitempty"`
+	ChatID             any    `json:"chat_id,omitempty"`
+	MessageID          int    `json:"message_id,omitempty"`
+	InlineMessageID    string `json:"inline_message_id,omitempty"`
 }
 
 type GetGameHighScoresParams struct {
-	UserID          int64 `json:"user_id"`
-	ChatID          any   `json:"chat_id,omitempty"`
-	MessageID       int   `json:"message_id,omitempty"`
-	InlineMessageID int   `json:"inline_message_id,omitempty"`
+	UserID          int64  `json:"user_id"`
+	ChatID          any    `json:"chat_id,omitempty"`
+	MessageID       int    `json:"message_id,omitempty"`
+	InlineMessageID string `json:"inline_message_id,omitempty"`
 }
 
 // SendGiftParams https://core.telegram.org/bots/api#sendgift
 type SendGiftParams struct {
-	UserID        int64                  `json:"user_id"`
+	UserID        int64                  `json:"user_id,omitempty"`
 	ChatID        any                    `json:"chat_id,omitempty"`
 	GiftID        string                 `json:"gift_id"`
 	PayForUpgrade bool                   `json:"pay_for_upgrade,omitempty"`
@@ -12,3 +12,5 @@ type Audio struct {
 	FileSize     int64      `json:"file_size,omitempty"`
 	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"`
 }
+
+func (a Audio) GetFileID() string { return a.FileID }
@@ -13,96 +13,126 @@ type BotCommandScope interface {
 type BotCommandScopeDefault struct{}
 
 func (m *BotCommandScopeDefault) MarshalCustom() ([]byte, error) {
+	type alias BotCommandScopeDefault
 	ret := struct {
 		Type string `json:"type"`
-		*BotCommandScopeDefault
+		alias
 	}{
-		Type:                   "default",
-		BotCommandScopeDefault: m,
+		Type:  "default",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *BotCommandScopeDefault) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // BotCommandScopeAllPrivateChats https://core.telegram.org/bots/api#botcommandscopeallprivatechats
 type BotCommandScopeAllPrivateChats struct{}
 
 func (m *BotCommandScopeAllPrivateChats) MarshalCustom() ([]byte, error) {
+	type alias BotCommandScopeAllPrivateChats
 	ret := struct {
 		Type string `json:"type"`
-		*BotCommandScopeAllPrivateChats
+		alias
 	}{
-		Type:                           "all_private_chats",
-		BotCommandScopeAllPrivateChats: m,
+		Type:  "all_private_chats",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *BotCommandScopeAllPrivateChats) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // BotCommandScopeAllGroupChats https://core.telegram.org/bots/api#botcommandscopeallgroupchats
 type BotCommandScopeAllGroupChats struct{}
 
 func (m *BotCommandScopeAllGroupChats) MarshalCustom() ([]byte, error) {
+	type alias BotCommandScopeAllGroupChats
 	ret := struct {
 		Type string `json:"type"`
-		*BotCommandScopeAllGroupChats
+		alias
 	}{
-		Type:                         "all_group_chats",
-		BotCommandScopeAllGroupChats: m,
+		Type:  "all_group_chats",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *BotCommandScopeAllGroupChats) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // BotCommandScopeAllChatAdministrators https://core.telegram.org/bots/api#botcommandscopeallchatadministrators
 type BotCommandScopeAllChatAdministrators struct{}
 
 func (m *BotCommandScopeAllChatAdministrators) MarshalCustom() ([]byte, error) {
+	type alias BotCommandScopeAllChatAdministrators
 	ret := struct {
 		Type string `json:"type"`
-		*BotCommandScopeAllChatAdministrators
+		alias
 	}{
-		Type:                                 "all_chat_administrators",
-		BotCommandScopeAllChatAdministrators: m,
+		Type:  "all_chat_administrators",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *BotCommandScopeAllChatAdministrators) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // BotCommandScopeChat https://core.telegram.org/bots/api#botcommandscopechat
 type BotCommandScopeChat struct {
 	ChatID any `json:"chat_id"`
 }
 
 func (m *BotCommandScopeChat) MarshalCustom() ([]byte, error) {
+	type alias BotCommandScopeChat
 	ret := struct {
 		Type string `json:"type"`
-		*BotCommandScopeChat
+		alias
 	}{
-		Type:                "chat",
-		BotCommandScopeChat: m,
+		Type:  "chat",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *BotCommandScopeChat) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // BotCommandScopeChatAdministrators https://core.telegram.org/bots/api#botcommandscopechatadministrators
 type BotCommandScopeChatAdministrators struct {
 	ChatID any `json:"chat_id"`
 }
 
 func (m *BotCommandScopeChatAdministrators) MarshalCustom() ([]byte, error) {
+	type alias BotCommandScopeChatAdministrators
 	ret := struct {
 		Type string `json:"type"`
-		*BotCommandScopeChatAdministrators
+		alias
 	}{
-		Type:                              "chat_administrators",
-		BotCommandScopeChatAdministrators: m,
+		Type:  "chat_administrators",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *BotCommandScopeChatAdministrators) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // BotCommandScopeChatMember https://core.telegram.org/bots/api#botcommandscopechatmember
 type BotCommandScopeChatMember struct {
 	ChatID any   `json:"chat_id"`
@@ -110,13 +140,18 @@ type BotCommandScopeChatMember struct {
 }
 
 func (m *BotCommandScopeChatMember) MarshalCustom() ([]byte, error) {
+	type alias BotCommandScopeChatMember
 	ret := struct {
 		Type string `json:"type"`
-		*BotCommandScopeChatMember
+		alias
 	}{
-		Type:                      "chat_member",
-		BotCommandScopeChatMember: m,
+		Type:  "chat_member",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
+
+func (m *BotCommandScopeChatMember) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBotCommandScope_MarshalJSON(t *testing.T) {
+	params := SetMyCommandsParams{
+		Commands: []BotCommand{{Command: "start", Description: "Start"}},
+		Scope:    &BotCommandScopeChatMember{ChatID: int64(-100123), UserID: 42},
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"commands":[{"command":"start","description":"Start"}],"scope":{"type":"chat_member","chat_id":-100123,"user_id":42}}`
+	if string(data) != expected {
+		t.Fatalf("wrong json, got %s", data)
+	}
+}
+
+func TestBotCommandScope_MarshalJSON_empty(t *testing.T) {
+	data, err := json.Marshal(GetMyCommandsParams{Scope: &BotCommandScopeAllPrivateChats{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `{"scope":{"type":"all_private_chats"}}` {
+		t.Fatalf("wrong json, got %s", data)
+	}
+}
+
+func TestMenuButton_roundTrip(t *testing.T) {
+	params := SetChatMenuButtonParams{
+		MenuButton: MenuButtonWebApp{
+			Text:   "Open",
+			WebApp: WebAppInfo{URL: "https://example.com"},
+		},
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v := struct {
+		MenuButton MenuButton `json:"menu_button"`
+	}{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if v.MenuButton.Type != MenuButtonTypeWebApp {
+		t.Fatalf("wrong type, got %q", v.MenuButton.Type)
+	}
+
+	if v.MenuButton.WebApp == nil {
+		t.Fatal("WebApp is nil")
+	}
+
+	if v.MenuButton.WebApp.Text != "Open" || v.MenuButton.WebApp.WebApp.URL != "https://example.com" {
+		t.Fatal("wrong web app fields")
+	}
+
+	again, err := json.Marshal(&v.MenuButton)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var button MenuButton
+	if err := json.Unmarshal(again, &button); err != nil {
+		t.Fatal(err)
+	}
+
+	if button.Type != MenuButtonTypeWebApp || button.WebApp.Text != "Open" {
+		t.Fatal("round trip mismatch")
+	}
+}
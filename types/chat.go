@@ -29,6 +29,8 @@ type ChatInviteLink struct {
 	ExpireDate              int    `json:"expire_date,omitempty"`
 	MemberLimit             int    `json:"member_limit,omitempty"`
 	PendingJoinRequestCount int    `json:"pending_join_request_count,omitempty"`
+	SubscriptionPeriod      int    `json:"subscription_period,omitempty"`
+	SubscriptionPrice       int    `json:"subscription_price,omitempty"`
 }
 
 // ChatAdministratorRights https://core.telegram.org/bots/api#chatadministratorrights
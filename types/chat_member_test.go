@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestChatMember_UnmarshalJSON_list(t *testing.T) {
+	src := `[
+		{"status":"creator","user":{"id":1},"is_anonymous":false,"custom_title":"boss"},
+		{"status":"administrator","user":{"id":2},"can_be_edited":true,"can_delete_messages":true},
+		{"status":"restricted","user":{"id":3},"is_member":true,"can_send_messages":false,"until_date":42}
+	]`
+
+	var members []ChatMember
+	err := json.Unmarshal([]byte(src), &members)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(members) != 3 {
+		t.Fatal("wrong members length")
+	}
+
+	if members[0].Type != ChatMemberTypeOwner || members[0].Owner == nil {
+		t.Fatal("invalid owner")
+	}
+	if members[0].Owner.CustomTitle != "boss" {
+		t.Fatal("wrong custom title")
+	}
+
+	if members[1].Type != ChatMemberTypeAdministrator || members[1].Administrator == nil {
+		t.Fatal("invalid administrator")
+	}
+	if members[1].Administrator.User.ID != 2 || !members[1].Administrator.CanDeleteMessages {
+		t.Fatal("wrong administrator fields")
+	}
+
+	if members[2].Type != ChatMemberTypeRestricted || members[2].Restricted == nil {
+		t.Fatal("invalid restricted")
+	}
+	if members[2].Restricted.UntilDate != 42 || !members[2].Restricted.IsMember {
+		t.Fatal("wrong restricted fields")
+	}
+}
+
+func TestChatMember_UnmarshalJSON_unknown(t *testing.T) {
+	var member ChatMember
+	err := json.Unmarshal([]byte(`{"status":"unknown"}`), &member)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
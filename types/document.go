@@ -9,3 +9,5 @@ type Document struct {
 	MimeType     string     `json:"mime_type,omitempty"`
 	FileSize     int64      `json:"file_size,omitempty"`
 }
+
+func (d Document) GetFileID() string { return d.FileID }
@@ -7,3 +7,11 @@ type File struct {
 	FileSize     int64  `json:"file_size,omitempty"`
 	FilePath     string `json:"file_path,omitempty"`
 }
+
+func (f File) GetFileID() string { return f.FileID }
+
+// Downloadable is implemented by every object that references a file stored
+// on the Telegram servers, such as PhotoSize, Document or Voice.
+type Downloadable interface {
+	GetFileID() string
+}
@@ -55,17 +55,22 @@ type InlineQueryResultArticle struct {
 func (*InlineQueryResultArticle) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultArticle) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultArticle
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultArticle
+		alias
 	}{
-		Type:                     "article",
-		InlineQueryResultArticle: m,
+		Type:  "article",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultPhoto https://core.telegram.org/bots/api#inlinequeryresultphoto
 type InlineQueryResultPhoto struct {
 	ID                    string              `json:"id"`
@@ -86,17 +91,22 @@ type InlineQueryResultPhoto struct {
 func (*InlineQueryResultPhoto) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultPhoto) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultPhoto
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultPhoto
+		alias
 	}{
-		Type:                   "photo",
-		InlineQueryResultPhoto: m,
+		Type:  "photo",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultGif https://core.telegram.org/bots/api#inlinequeryresultgif
 type InlineQueryResultGif struct {
 	ID                    string              `json:"id"`
@@ -118,17 +128,22 @@ type InlineQueryResultGif struct {
 func (*InlineQueryResultGif) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultGif) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultGif
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultGif
+		alias
 	}{
-		Type:                 "gif",
-		InlineQueryResultGif: m,
+		Type:  "gif",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultGif) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultMpeg4Gif https://core.telegram.org/bots/api#inlinequeryresultmpeg4gif
 type InlineQueryResultMpeg4Gif struct {
 	ID                    string              `json:"id"`
@@ -150,17 +165,22 @@ type InlineQueryResultMpeg4Gif struct {
 func (*InlineQueryResultMpeg4Gif) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultMpeg4Gif) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultMpeg4Gif
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultMpeg4Gif
+		alias
 	}{
-		Type:                      "mpeg4_gif",
-		InlineQueryResultMpeg4Gif: m,
+		Type:  "mpeg4_gif",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultMpeg4Gif) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultVideo https://core.telegram.org/bots/api#inlinequeryresultvideo
 type InlineQueryResultVideo struct {
 	ID                    string              `json:"id"`
@@ -183,17 +203,22 @@ type InlineQueryResultVideo struct {
 func (*InlineQueryResultVideo) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultVideo) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultVideo
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultVideo
+		alias
 	}{
-		Type:                   "video",
-		InlineQueryResultVideo: m,
+		Type:  "video",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultAudio https://core.telegram.org/bots/api#inlinequeryresultaudio
 type InlineQueryResultAudio struct {
 	ID                  string              `json:"id"`
@@ -211,17 +236,22 @@ type InlineQueryResultAudio struct {
 func (*InlineQueryResultAudio) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultAudio) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultAudio
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultAudio
+		alias
 	}{
-		Type:                   "audio",
-		InlineQueryResultAudio: m,
+		Type:  "audio",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultVoice https://core.telegram.org/bots/api#inlinequeryresultvoice
 type InlineQueryResultVoice struct {
 	ID                  string              `json:"id"`
@@ -238,17 +268,22 @@ type InlineQueryResultVoice struct {
 func (*InlineQueryResultVoice) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultVoice) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultVoice
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultVoice
+		alias
 	}{
-		Type:                   "voice",
-		InlineQueryResultVoice: m,
+		Type:  "voice",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultVoice) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultDocument https://core.telegram.org/bots/api#inlinequeryresultdocument
 type InlineQueryResultDocument struct {
 	ID                  string              `json:"id"`
@@ -269,17 +304,22 @@ type InlineQueryResultDocument struct {
 func (*InlineQueryResultDocument) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultDocument) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultDocument
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultDocument
+		alias
 	}{
-		Type:                      "document",
-		InlineQueryResultDocument: m,
+		Type:  "document",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultLocation https://core.telegram.org/bots/api#inlinequeryresultlocation
 type InlineQueryResultLocation struct {
 	ID                   string              `json:"id"`
@@ -300,17 +340,22 @@ type InlineQueryResultLocation struct {
 func (*InlineQueryResultLocation) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultLocation) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultLocation
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultLocation
+		alias
 	}{
-		Type:                      "location",
-		InlineQueryResultLocation: m,
+		Type:  "location",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultVenue https://core.telegram.org/bots/api#inlinequeryresultvenue
 type InlineQueryResultVenue struct {
 	ID                  string              `json:"id"`
@@ -332,17 +377,22 @@ type InlineQueryResultVenue struct {
 func (*InlineQueryResultVenue) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultVenue) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultVenue
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultVenue
+		alias
 	}{
-		Type:                   "venue",
-		InlineQueryResultVenue: m,
+		Type:  "venue",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultContact https://core.telegram.org/bots/api#inlinequeryresultcontact
 type InlineQueryResultContact struct {
 	ID                  string              `json:"id"`
@@ -360,17 +410,22 @@ type InlineQueryResultContact struct {
 func (*InlineQueryResultContact) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultContact) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultContact
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultContact
+		alias
 	}{
-		Type:                     "contact",
-		InlineQueryResultContact: m,
+		Type:  "contact",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultGame https://core.telegram.org/bots/api#inlinequeryresultgame
 type InlineQueryResultGame struct {
 	ID            string      `json:"id"`
@@ -381,17 +436,22 @@ type InlineQueryResultGame struct {
 func (*InlineQueryResultGame) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultGame) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultGame
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultGame
+		alias
 	}{
-		Type:                  "game",
-		InlineQueryResultGame: m,
+		Type:  "game",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultCachedPhoto https://core.telegram.org/bots/api#inlinequeryresultcachedphoto
 type InlineQueryResultCachedPhoto struct {
 	ID                    string              `json:"id"`
@@ -409,17 +469,22 @@ type InlineQueryResultCachedPhoto struct {
 func (*InlineQueryResultCachedPhoto) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultCachedPhoto) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultCachedPhoto
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultCachedPhoto
+		alias
 	}{
-		Type:                         "photo",
-		InlineQueryResultCachedPhoto: m,
+		Type:  "photo",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultCachedPhoto) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultCachedGif https://core.telegram.org/bots/api#inlinequeryresultcachedgif
 type InlineQueryResultCachedGif struct {
 	ID                    string              `json:"id"`
@@ -436,17 +501,22 @@ type InlineQueryResultCachedGif struct {
 func (*InlineQueryResultCachedGif) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultCachedGif) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultCachedGif
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultCachedGif
+		alias
 	}{
-		Type:                       "gif",
-		InlineQueryResultCachedGif: m,
+		Type:  "gif",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultCachedGif) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultCachedMpeg4Gif https://core.telegram.org/bots/api#inlinequeryresultcachedmpeg4gif
 type InlineQueryResultCachedMpeg4Gif struct {
 	ID                    string              `json:"id"`
@@ -463,17 +533,22 @@ type InlineQueryResultCachedMpeg4Gif struct {
 func (*InlineQueryResultCachedMpeg4Gif) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultCachedMpeg4Gif) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultCachedMpeg4Gif
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultCachedMpeg4Gif
+		alias
 	}{
-		Type:                            "mpeg4_gif",
-		InlineQueryResultCachedMpeg4Gif: m,
+		Type:  "mpeg4_gif",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultCachedMpeg4Gif) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultCachedSticker https://core.telegram.org/bots/api#inlinequeryresultcachedsticker
 type InlineQueryResultCachedSticker struct {
 	ID                  string              `json:"id"`
@@ -485,17 +560,22 @@ type InlineQueryResultCachedSticker struct {
 func (*InlineQueryResultCachedSticker) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultCachedSticker) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultCachedSticker
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultCachedSticker
+		alias
 	}{
-		Type:                           "sticker",
-		InlineQueryResultCachedSticker: m,
+		Type:  "sticker",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultCachedSticker) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultCachedDocument https://core.telegram.org/bots/api#inlinequeryresultcacheddocument
 type InlineQueryResultCachedDocument struct {
 	ID                  string              `json:"id"`
@@ -512,17 +592,22 @@ type InlineQueryResultCachedDocument struct {
 func (*InlineQueryResultCachedDocument) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultCachedDocument) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultCachedDocument
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultCachedDocument
+		alias
 	}{
-		Type:                            "document",
-		InlineQueryResultCachedDocument: m,
+		Type:  "document",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultCachedDocument) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultCachedVideo https://core.telegram.org/bots/api#inlinequeryresultcachedvideo
 type InlineQueryResultCachedVideo struct {
 	ID                    string              `json:"id"`
@@ -540,17 +625,22 @@ type InlineQueryResultCachedVideo struct {
 func (*InlineQueryResultCachedVideo) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultCachedVideo) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultCachedVideo
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultCachedVideo
+		alias
 	}{
-		Type:                         "video",
-		InlineQueryResultCachedVideo: m,
+		Type:  "video",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultCachedVideo) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultCachedVoice https://core.telegram.org/bots/api#inlinequeryresultcachedvoice
 type InlineQueryResultCachedVoice struct {
 	ID                  string              `json:"id"`
@@ -566,17 +656,22 @@ type InlineQueryResultCachedVoice struct {
 func (*InlineQueryResultCachedVoice) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultCachedVoice) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultCachedVoice
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultCachedVoice
+		alias
 	}{
-		Type:                         "voice",
-		InlineQueryResultCachedVoice: m,
+		Type:  "voice",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultCachedVoice) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InlineQueryResultCachedAudio https://core.telegram.org/bots/api#inlinequeryresultcachedaudio
 type InlineQueryResultCachedAudio struct {
 	ID                  string              `json:"id"`
@@ -591,17 +686,22 @@ type InlineQueryResultCachedAudio struct {
 func (*InlineQueryResultCachedAudio) inlineQueryResultTag() {}
 
 func (m *InlineQueryResultCachedAudio) MarshalCustom() ([]byte, error) {
+	type alias InlineQueryResultCachedAudio
 	ret := struct {
 		Type string `json:"type"`
-		*InlineQueryResultCachedAudio
+		alias
 	}{
-		Type:                         "audio",
-		InlineQueryResultCachedAudio: m,
+		Type:  "audio",
+		alias: alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *InlineQueryResultCachedAudio) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // InputMessageContent https://core.telegram.org/bots/api#inputmessagecontent
 type InputMessageContent interface {
 	inputMessageContentTag()
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestInlineQueryResult_MarshalJSON(t *testing.T) {
+	params := AnswerInlineQueryParams{
+		InlineQueryID: "1",
+		Results: []InlineQueryResult{
+			&InlineQueryResultArticle{
+				ID:    "a",
+				Title: "Hello",
+				InputMessageContent: InputTextMessageContent{
+					MessageText: "hello",
+					ParseMode:   ParseModeHTML,
+				},
+			},
+			&InlineQueryResultCachedSticker{
+				ID:            "s",
+				StickerFileID: "file",
+			},
+		},
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"inline_query_id":"1","results":[` +
+		`{"type":"article","id":"a","title":"Hello","input_message_content":{"message_text":"hello","parse_mode":"HTML"}},` +
+		`{"type":"sticker","id":"s","sticker_file_id":"file"}]}`
+	if string(data) != expected {
+		t.Fatalf("wrong json, got %s", data)
+	}
+}
+
+func TestInlineQueryResult_MarshalJSON_location(t *testing.T) {
+	params := AnswerWebAppQueryParams{
+		WebAppQueryID: "q",
+		Result: &InlineQueryResultLocation{
+			ID:        "l",
+			Latitude:  1.5,
+			Longitude: 2.5,
+			Title:     "Here",
+			InputMessageContent: InputLocationMessageContent{
+				Latitude:  1.5,
+				Longitude: 2.5,
+			},
+		},
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v := struct {
+		Result map[string]any `json:"result"`
+	}{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if v.Result["type"] != "location" {
+		t.Fatalf("wrong type, got %v", v.Result["type"])
+	}
+
+	content, ok := v.Result["input_message_content"].(map[string]any)
+	if !ok || content["latitude"] != 1.5 {
+		t.Fatalf("wrong input_message_content, got %v", v.Result["input_message_content"])
+	}
+}
@@ -19,8 +19,11 @@ type InputFileUpload struct {
 
 func (*InputFileUpload) inputFileTag() {}
 
+// MarshalJSON references the upload as "attach://<Filename>". Inside media
+// objects the reference is replaced by a unique name when the request is
+// written.
 func (i *InputFileUpload) MarshalJSON() ([]byte, error) {
-	return []byte(`"@` + i.Filename + `"`), nil
+	return json.Marshal("attach://" + i.Filename)
 }
 
 type InputFileString struct {
@@ -30,7 +33,7 @@ type InputFileString struct {
 func (*InputFileString) inputFileTag() {}
 
 func (i *InputFileString) MarshalJSON() ([]byte, error) {
-	return []byte(`"` + i.Data + `"`), nil
+	return json.Marshal(i.Data)
 }
 
 func (i *InputFileString) UnmarshalJSON(data []byte) error {
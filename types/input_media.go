@@ -6,6 +6,10 @@ import (
 )
 
 // InputMedia https://core.telegram.org/bots/api#inputmedia
+//
+// A local file is uploaded by setting MediaAttachment and referencing it as
+// "attach://<name>" in the media field. The same applies to the other media
+// objects with a MediaAttachment or StickerAttachment field.
 type InputMedia interface {
 	inputMediaTag()
 
@@ -13,6 +13,8 @@ const (
 	MenuButtonTypeDefault  MenuButtonType = "default"
 )
 
+// InputMenuButton is implemented by MenuButtonCommands, MenuButtonWebApp and
+// MenuButtonDefault. Each of them always marshals its own "type".
 type InputMenuButton interface {
 	menuButtonTag()
 }
@@ -75,6 +77,13 @@ type MenuButtonCommands struct {
 
 func (MenuButtonCommands) menuButtonTag() {}
 
+func (m MenuButtonCommands) MarshalJSON() ([]byte, error) {
+	type alias MenuButtonCommands
+	a := alias(m)
+	a.Type = MenuButtonTypeCommands
+	return json.Marshal(a)
+}
+
 // MenuButtonWebApp https://core.telegram.org/bots/api#menubuttonwebapp
 type MenuButtonWebApp struct {
 	Type   MenuButtonType `json:"type" rules:"required,equals:web_app"`
@@ -84,9 +93,23 @@ type MenuButtonWebApp struct {
 
 func (MenuButtonWebApp) menuButtonTag() {}
 
+func (m MenuButtonWebApp) MarshalJSON() ([]byte, error) {
+	type alias MenuButtonWebApp
+	a := alias(m)
+	a.Type = MenuButtonTypeWebApp
+	return json.Marshal(a)
+}
+
 // MenuButtonDefault https://core.telegram.org/bots/api#menubuttondefault
 type MenuButtonDefault struct {
 	Type MenuButtonType `json:"type" rules:"required,equals:default"`
 }
 
 func (MenuButtonDefault) menuButtonTag() {}
+
+func (m MenuButtonDefault) MarshalJSON() ([]byte, error) {
+	type alias MenuButtonDefault
+	a := alias(m)
+	a.Type = MenuButtonTypeDefault
+	return json.Marshal(a)
+}
@@ -52,6 +52,44 @@ func (mim *MaybeInaccessibleMessage) MarshalJSON() ([]byte, error) {
 	return nil, fmt.Errorf("unsupported MaybeInaccessibleMessage type")
 }
 
+type MessageOrTrueType int
+
+const (
+	MessageOrTrueTypeMessage MessageOrTrueType = iota
+	MessageOrTrueTypeTrue
+)
+
+// MessageOrTrue is returned by methods that edit messages. Telegram returns
+// the edited Message for chat messages and True for inline messages.
+type MessageOrTrue struct {
+	Type MessageOrTrueType
+
+	Message *Message
+}
+
+func (mot *MessageOrTrue) UnmarshalJSON(data []byte) error {
+	if string(data) == "true" {
+		mot.Type = MessageOrTrueTypeTrue
+		mot.Message = nil
+		return nil
+	}
+
+	mot.Type = MessageOrTrueTypeMessage
+	mot.Message = &Message{}
+	return json.Unmarshal(data, mot.Message)
+}
+
+func (mot *MessageOrTrue) MarshalJSON() ([]byte, error) {
+	switch mot.Type {
+	case MessageOrTrueTypeMessage:
+		return json.Marshal(mot.Message)
+	case MessageOrTrueTypeTrue:
+		return []byte("true"), nil
+	}
+
+	return nil, fmt.Errorf("unsupported MessageOrTrue type")
+}
+
 // InaccessibleMessage https://core.telegram.org/bots/api#inaccessiblemessage
 type InaccessibleMessage struct {
 	Chat      Chat `json:"chat"`
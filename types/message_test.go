@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMessageOrTrue_UnmarshalJSON_Message(t *testing.T) {
+	src := `{"message_id":42,"date":1,"chat":{"id":123,"type":"private"},"text":"edited"}`
+
+	var mot MessageOrTrue
+	err := json.Unmarshal([]byte(src), &mot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mot.Type != MessageOrTrueTypeMessage {
+		t.Fatal("wrong type")
+	}
+
+	if mot.Message == nil {
+		t.Fatal("Message is nil")
+	}
+
+	if mot.Message.ID != 42 {
+		t.Fatal("wrong message id")
+	}
+
+	if mot.Message.Text != "edited" {
+		t.Fatal("wrong text")
+	}
+}
+
+func TestMessageOrTrue_UnmarshalJSON_True(t *testing.T) {
+	var mot MessageOrTrue
+	err := json.Unmarshal([]byte(`true`), &mot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mot.Type != MessageOrTrueTypeTrue {
+		t.Fatal("wrong type")
+	}
+
+	if mot.Message != nil {
+		t.Fatal("Message is not nil")
+	}
+}
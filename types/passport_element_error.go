@@ -22,17 +22,22 @@ type PassportElementErrorDataField struct {
 func (PassportElementErrorDataField) passportElementErrorTag() {}
 
 func (m *PassportElementErrorDataField) MarshalCustom() ([]byte, error) {
+	type alias PassportElementErrorDataField
 	ret := struct {
-		Type string `json:"type"`
-		*PassportElementErrorDataField
+		Source string `json:"source"`
+		alias
 	}{
-		Type:                          "data",
-		PassportElementErrorDataField: m,
+		Source: "data",
+		alias:  alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *PassportElementErrorDataField) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // PassportElementErrorFrontSide https://core.telegram.org/bots/api#passportelementerrorfrontside
 type PassportElementErrorFrontSide struct {
 	Type     string `json:"type"`
@@ -43,17 +48,22 @@ type PassportElementErrorFrontSide struct {
 func (PassportElementErrorFrontSide) passportElementErrorTag() {}
 
 func (m *PassportElementErrorFrontSide) MarshalCustom() ([]byte, error) {
+	type alias PassportElementErrorFrontSide
 	ret := struct {
-		Type string `json:"type"`
-		*PassportElementErrorFrontSide
+		Source string `json:"source"`
+		alias
 	}{
-		Type:                          "front_side",
-		PassportElementErrorFrontSide: m,
+		Source: "front_side",
+		alias:  alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *PassportElementErrorFrontSide) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // PassportElementErrorReverseSide https://core.telegram.org/bots/api#passportelementerrorreverseside
 type PassportElementErrorReverseSide struct {
 	Type     string `json:"type"`
@@ -64,17 +74,22 @@ type PassportElementErrorReverseSide struct {
 func (PassportElementErrorReverseSide) passportElementErrorTag() {}
 
 func (m *PassportElementErrorReverseSide) MarshalCustom() ([]byte, error) {
+	type alias PassportElementErrorReverseSide
 	ret := struct {
-		Type string `json:"type"`
-		*PassportElementErrorReverseSide
+		Source string `json:"source"`
+		alias
 	}{
-		Type:                            "reverse_side",
-		PassportElementErrorReverseSide: m,
+		Source: "reverse_side",
+		alias:  alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *PassportElementErrorReverseSide) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // PassportElementErrorSelfie https://core.telegram.org/bots/api#passportelementerrorselfie
 type PassportElementErrorSelfie struct {
 	Type     string `json:"type"`
@@ -85,17 +100,22 @@ type PassportElementErrorSelfie struct {
 func (PassportElementErrorSelfie) passportElementErrorTag() {}
 
 func (m *PassportElementErrorSelfie) MarshalCustom() ([]byte, error) {
+	type alias PassportElementErrorSelfie
 	ret := struct {
-		Type string `json:"type"`
-		*PassportElementErrorSelfie
+		Source string `json:"source"`
+		alias
 	}{
-		Type:                       "selfie",
-		PassportElementErrorSelfie: m,
+		Source: "selfie",
+		alias:  alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *PassportElementErrorSelfie) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // PassportElementErrorFile https://core.telegram.org/bots/api#passportelementerrorfile
 type PassportElementErrorFile struct {
 	Type     string `json:"type"`
@@ -106,17 +126,22 @@ type PassportElementErrorFile struct {
 func (PassportElementErrorFile) passportElementErrorTag() {}
 
 func (m *PassportElementErrorFile) MarshalCustom() ([]byte, error) {
+	type alias PassportElementErrorFile
 	ret := struct {
-		Type string `json:"type"`
-		*PassportElementErrorFile
+		Source string `json:"source"`
+		alias
 	}{
-		Type:                     "file",
-		PassportElementErrorFile: m,
+		Source: "file",
+		alias:  alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *PassportElementErrorFile) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // PassportElementErrorFiles https://core.telegram.org/bots/api#passportelementerrorfiles
 type PassportElementErrorFiles struct {
 	Type       string   `json:"type"`
@@ -127,17 +152,22 @@ type PassportElementErrorFiles struct {
 func (PassportElementErrorFiles) passportElementErrorTag() {}
 
 func (m *PassportElementErrorFiles) MarshalCustom() ([]byte, error) {
+	type alias PassportElementErrorFiles
 	ret := struct {
-		Type string `json:"type"`
-		*PassportElementErrorFiles
+		Source string `json:"source"`
+		alias
 	}{
-		Type:                      "files",
-		PassportElementErrorFiles: m,
+		Source: "files",
+		alias:  alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *PassportElementErrorFiles) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // PassportElementErrorTranslationFile https://core.telegram.org/bots/api#passportelementerrortranslationfile
 type PassportElementErrorTranslationFile struct {
 	Type     string `json:"type"`
@@ -148,17 +178,22 @@ type PassportElementErrorTranslationFile struct {
 func (PassportElementErrorTranslationFile) passportElementErrorTag() {}
 
 func (m *PassportElementErrorTranslationFile) MarshalCustom() ([]byte, error) {
+	type alias PassportElementErrorTranslationFile
 	ret := struct {
-		Type string `json:"type"`
-		*PassportElementErrorTranslationFile
+		Source string `json:"source"`
+		alias
 	}{
-		Type:                                "translation_file",
-		PassportElementErrorTranslationFile: m,
+		Source: "translation_file",
+		alias:  alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *PassportElementErrorTranslationFile) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // PassportElementErrorTranslationFiles https://core.telegram.org/bots/api#passportelementerrortranslationfiles
 type PassportElementErrorTranslationFiles struct {
 	Type       string   `json:"type"`
@@ -169,17 +204,22 @@ type PassportElementErrorTranslationFiles struct {
 func (PassportElementErrorTranslationFiles) passportElementErrorTag() {}
 
 func (m *PassportElementErrorTranslationFiles) MarshalCustom() ([]byte, error) {
+	type alias PassportElementErrorTranslationFiles
 	ret := struct {
-		Type string `json:"type"`
-		*PassportElementErrorTranslationFiles
+		Source string `json:"source"`
+		alias
 	}{
-		Type:                                 "translation_files",
-		PassportElementErrorTranslationFiles: m,
+		Source: "translation_files",
+		alias:  alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
 
+func (m *PassportElementErrorTranslationFiles) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
+
 // PassportElementErrorUnspecified https://core.telegram.org/bots/api#passportelementerrorunspecified
 type PassportElementErrorUnspecified struct {
 	Type        string `json:"type"`
@@ -190,13 +230,18 @@ type PassportElementErrorUnspecified struct {
 func (PassportElementErrorUnspecified) passportElementErrorTag() {}
 
 func (m *PassportElementErrorUnspecified) MarshalCustom() ([]byte, error) {
+	type alias PassportElementErrorUnspecified
 	ret := struct {
-		Type string `json:"type"`
-		*PassportElementErrorUnspecified
+		Source string `json:"source"`
+		alias
 	}{
-		Type:                            "unspecified",
-		PassportElementErrorUnspecified: m,
+		Source: "unspecified",
+		alias:  alias(*m),
 	}
 
 	return json.Marshal(&ret)
 }
+
+func (m *PassportElementErrorUnspecified) MarshalJSON() ([]byte, error) {
+	return m.MarshalCustom()
+}
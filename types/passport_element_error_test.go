@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPassportElementError_MarshalJSON(t *testing.T) {
+	params := SetPassportDataErrorsParams{
+		UserID: 1,
+		Errors: []PassportElementError{
+			&PassportElementErrorDataField{
+				Type:      "personal_details",
+				FieldName: "first_name",
+				DataHash:  "hash",
+				Message:   "wrong name",
+			},
+			&PassportElementErrorFiles{
+				Type:       "utility_bill",
+				FileHashes: []string{"a", "b"},
+				Message:    "unreadable",
+			},
+		},
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded struct {
+		Errors []map[string]any `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(decoded.Errors) != 2 {
+		t.Fatal("wrong errors length")
+	}
+
+	if decoded.Errors[0]["source"] != "data" || decoded.Errors[0]["type"] != "personal_details" {
+		t.Fatal("wrong data field error", decoded.Errors[0])
+	}
+
+	if decoded.Errors[0]["field_name"] != "first_name" {
+		t.Fatal("wrong field name")
+	}
+
+	if decoded.Errors[1]["source"] != "files" || decoded.Errors[1]["type"] != "utility_bill" {
+		t.Fatal("wrong files error", decoded.Errors[1])
+	}
+}
@@ -8,3 +8,5 @@ type PhotoSize struct {
 	Height       int    `json:"height"`
 	FileSize     int    `json:"file_size"`
 }
+
+func (p PhotoSize) GetFileID() string { return p.FileID }
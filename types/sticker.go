@@ -1,6 +1,9 @@
 package types
 
-import "io"
+import (
+	"encoding/json"
+	"io"
+)
 
 // MaskPosition https://core.telegram.org/bots/api#maskposition
 type MaskPosition struct {
@@ -29,6 +32,8 @@ type Sticker struct {
 	FileSize         int           `json:"file_size,omitempty"`
 }
 
+func (s Sticker) GetFileID() string { return s.FileID }
+
 // InputSticker https://core.telegram.org/bots/api#inputsticker
 type InputSticker struct {
 	Sticker      string        `json:"sticker"`
@@ -39,3 +44,10 @@ type InputSticker struct {
 
 	StickerAttachment io.Reader `json:"-"`
 }
+
+func (i InputSticker) GetMedia() string      { return i.Sticker }
+func (i InputSticker) Attachment() io.Reader { return i.StickerAttachment }
+
+func (i InputSticker) MarshalInputMedia() ([]byte, error) {
+	return json.Marshal(i)
+}
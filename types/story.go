@@ -148,6 +148,48 @@ func (s *StoryAreaType) UnmarshalJSON(data []byte) error {
 	return fmt.Errorf("unsupported StoryAreaType type")
 }
 
+func (s StoryAreaType) MarshalJSON() ([]byte, error) {
+	switch s.Type {
+	case StoryAreaTypeTypeLocation:
+		if s.StoryAreaTypeLocation == nil {
+			return nil, fmt.Errorf("StoryAreaType %s has no StoryAreaTypeLocation", s.Type)
+		}
+		v := *s.StoryAreaTypeLocation
+		v.Type = StoryAreaTypeTypeLocation
+		return json.Marshal(&v)
+	case StoryAreaTypeTypeSuggestedReaction:
+		if s.StoryAreaTypeSuggestedReaction == nil {
+			return nil, fmt.Errorf("StoryAreaType %s has no StoryAreaTypeSuggestedReaction", s.Type)
+		}
+		v := *s.StoryAreaTypeSuggestedReaction
+		v.Type = StoryAreaTypeTypeSuggestedReaction
+		return json.Marshal(&v)
+	case StoryAreaTypeTypeLink:
+		if s.StoryAreaTypeLink == nil {
+			return nil, fmt.Errorf("StoryAreaType %s has no StoryAreaTypeLink", s.Type)
+		}
+		v := *s.StoryAreaTypeLink
+		v.Type = StoryAreaTypeTypeLink
+		return json.Marshal(&v)
+	case StoryAreaTypeTypeWeather:
+		if s.StoryAreaTypeWeather == nil {
+			return nil, fmt.Errorf("StoryAreaType %s has no StoryAreaTypeWeather", s.Type)
+		}
+		v := *s.StoryAreaTypeWeather
+		v.Type = StoryAreaTypeTypeWeather
+		return json.Marshal(&v)
+	case StoryAreaTypeTypeUniqueGift:
+		if s.StoryAreaTypeUniqueGift == nil {
+			return nil, fmt.Errorf("StoryAreaType %s has no StoryAreaTypeUniqueGift", s.Type)
+		}
+		v := *s.StoryAreaTypeUniqueGift
+		v.Type = StoryAreaTypeTypeUniqueGift
+		return json.Marshal(&v)
+	}
+
+	return nil, fmt.Errorf("unsupported StoryAreaType type")
+}
+
 // StoryAreaTypeLocation https://core.telegram.org/bots/api#storyareatypelocation
 type StoryAreaTypeLocation struct {
 	Type      StoryAreaTypeType `json:"type"`
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStoryAreaType_roundTrip(t *testing.T) {
+	areas := []StoryArea{
+		{
+			Position: StoryAreaPosition{XPercentage: 10, YPercentage: 20},
+			Type: StoryAreaType{
+				Type:              StoryAreaTypeTypeLink,
+				StoryAreaTypeLink: &StoryAreaTypeLink{URL: "https://example.com"},
+			},
+		},
+		{
+			Type: StoryAreaType{
+				Type: StoryAreaTypeTypeLocation,
+				StoryAreaTypeLocation: &StoryAreaTypeLocation{
+					Latitude:  1.5,
+					Longitude: 2.5,
+				},
+			},
+		},
+	}
+
+	data, err := json.Marshal(areas)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded []StoryArea
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(decoded) != 2 {
+		t.Fatal("wrong areas length")
+	}
+
+	if decoded[0].Type.Type != StoryAreaTypeTypeLink || decoded[0].Type.StoryAreaTypeLink == nil {
+		t.Fatal("invalid link area")
+	}
+	if decoded[0].Type.StoryAreaTypeLink.URL != "https://example.com" {
+		t.Fatal("wrong url")
+	}
+	if decoded[0].Position.YPercentage != 20 {
+		t.Fatal("wrong position")
+	}
+
+	if decoded[1].Type.Type != StoryAreaTypeTypeLocation || decoded[1].Type.StoryAreaTypeLocation == nil {
+		t.Fatal("invalid location area")
+	}
+	if decoded[1].Type.StoryAreaTypeLocation.Longitude != 2.5 {
+		t.Fatal("wrong longitude")
+	}
+}
+
+func TestStoryAreaType_MarshalJSON_value(t *testing.T) {
+	link := &StoryAreaTypeLink{URL: "https://example.com"}
+	area := StoryArea{
+		Type: StoryAreaType{
+			Type:              StoryAreaTypeTypeLink,
+			StoryAreaTypeLink: link,
+		},
+	}
+
+	data, err := json.Marshal(area)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded struct {
+		Type map[string]any `json:"type"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Type["type"] != "link" || decoded.Type["url"] != "https://example.com" {
+		t.Fatal("wrong area type", string(data))
+	}
+
+	if link.Type != "" {
+		t.Fatal("caller's link was changed")
+	}
+}
+
+func TestStoryAreaType_MarshalJSON_nilVariant(t *testing.T) {
+	area := StoryArea{
+		Type: StoryAreaType{Type: StoryAreaTypeTypeLink},
+	}
+
+	if _, err := json.Marshal(area); err == nil {
+		t.Fatal("expected an error for a missing link")
+	}
+}
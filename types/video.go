@@ -14,3 +14,5 @@ type Video struct {
 	MimeType       string      `json:"mime_type,omitempty"`
 	FileSize       int64       `json:"file_size,omitempty"`
 }
+
+func (v Video) GetFileID() string { return v.FileID }
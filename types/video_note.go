@@ -9,3 +9,5 @@ type VideoNote struct {
 	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"`
 	FileSize     int        `json:"file_size,omitempty"`
 }
+
+func (v VideoNote) GetFileID() string { return v.FileID }
@@ -8,3 +8,5 @@ type Voice struct {
 	MimeType     string `json:"mime_type,omitempty"`
 	FileSize     int64  `json:"file_size,omitempty"`
 }
+
+func (v Voice) GetFileID() string { return v.FileID }
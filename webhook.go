@@ -1,12 +1,21 @@
 package gramgo
 
 import (
+	"bytes"
 	"context"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
+	"fmt"
 	"io"
 	"log"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/OhMyDitzzy/gramgo/types"
@@ -16,7 +25,7 @@ import (
 type WebhookConfig struct {
 	URL                string   // HTTPS URL to send updates to (required for StartWebhook)
 	ListenAddr         string   // Address to listen on (default: ":8443")
-	Certificate        string   // Path to public key certificate
+	Certificate        string   // Path to PEM encoded public key certificate, for self-signed deployments
 	IPAddress          string   // Fixed IP address
 	MaxConnections     int      // Maximum allowed connections (1-100, default: 40)
 	AllowedUpdates     []string // List of update types to receive
@@ -147,10 +156,11 @@ func (b *GramGoBot) StartWebhook(ctx context.Context, configs ...WebhookConfig)
 	}
 
 	if config.Certificate != "" {
-		// TODO: Load certificate file if provided
-		// For now, user should set webhook manually if they need certificate
-		
-		log.Printf("Warning: Certificate loading not yet implemented")
+		certificate, err := loadWebhookCertificate(config.Certificate, config.URL)
+		if err != nil {
+			return err
+		}
+		params.Certificate = certificate
 	}
 
 	if err := b.SetWebhook(ctx, params); err != nil {
@@ -193,4 +203,59 @@ func (b *GramGoBot) StartWebhook(ctx context.Context, configs ...WebhookConfig)
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// loadWebhookCertificate reads the PEM certificate at path and makes sure it
+// is valid for the host of webhookURL, so a mismatch is reported before
+// Telegram silently fails to deliver updates.
+func loadWebhookCertificate(path, webhookURL string) (*types.InputFileUpload, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook certificate: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("%w: %s does not contain a PEM encoded certificate", ErrInvalidCertificate, path)
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCertificate, err)
+	}
+
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook URL: %w", err)
+	}
+
+	if err := verifyCertificateHost(cert, u.Hostname()); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCertificateHostMismatch, err)
+	}
+
+	return &types.InputFileUpload{
+		Filename: filepath.Base(path),
+		Data:     bytes.NewReader(data),
+	}, nil
+}
+
+// verifyCertificateHost checks that cert is valid for host. Certificates made
+// by the openssl command in Telegram's self-signed certificate guide only set
+// the Common Name, which x509.Certificate.VerifyHostname ignores, so the
+// Common Name is compared directly when the certificate has no SANs.
+func verifyCertificateHost(cert *x509.Certificate, host string) error {
+	if len(cert.DNSNames) > 0 || len(cert.IPAddresses) > 0 {
+		return cert.VerifyHostname(host)
+	}
+
+	commonName := cert.Subject.CommonName
+	if ip := net.ParseIP(host); ip != nil {
+		if cnIP := net.ParseIP(commonName); cnIP != nil && cnIP.Equal(ip) {
+			return nil
+		}
+	} else if strings.EqualFold(strings.TrimSuffix(commonName, "."), strings.TrimSuffix(host, ".")) {
+		return nil
+	}
+
+	return fmt.Errorf("certificate is valid for %s, not %s", commonName, host)
+}
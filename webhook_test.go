@@ -0,0 +1,118 @@
+package gramgo
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCertificate creates a self-signed certificate and writes it PEM
+// encoded into a temporary file.
+func writeCertificate(t *testing.T, commonName string, dnsNames []string, ips []net.IP) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     dnsNames,
+		IPAddresses:  ips,
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "public.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestLoadWebhookCertificate(t *testing.T) {
+	ip := net.ParseIP("203.0.113.5")
+
+	tests := []struct {
+		name       string
+		commonName string
+		dnsNames   []string
+		ips        []net.IP
+		url        string
+		wantErr    error
+	}{
+		{"SAN IP", "", nil, []net.IP{ip}, "https://203.0.113.5:8443/hook", nil},
+		{"SAN DNS", "", []string{"bot.example.com"}, nil, "https://BOT.example.com/hook", nil},
+		{"CN only IP", "203.0.113.5", nil, nil, "https://203.0.113.5:8443/hook", nil},
+		{"CN only DNS", "Bot.Example.com", nil, nil, "https://bot.example.com/hook", nil},
+		{"SAN IP mismatch", "", nil, []net.IP{ip}, "https://203.0.113.6/hook", ErrCertificateHostMismatch},
+		{"SAN DNS mismatch", "", []string{"bot.example.com"}, nil, "https://other.example.com/hook", ErrCertificateHostMismatch},
+		{"SAN ignores CN", "bot.example.com", []string{"other.example.com"}, nil, "https://bot.example.com/hook", ErrCertificateHostMismatch},
+		{"CN only mismatch", "203.0.113.5", nil, nil, "https://bot.example.com/hook", ErrCertificateHostMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeCertificate(t, tt.commonName, tt.dnsNames, tt.ips)
+
+			upload, err := loadWebhookCertificate(path, tt.url)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			want, _ := os.ReadFile(path)
+			got, _ := io.ReadAll(upload.Data)
+			if upload.Filename != "public.pem" || string(got) != string(want) {
+				t.Fatal("wrong certificate upload")
+			}
+		})
+	}
+}
+
+func TestLoadWebhookCertificate_invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	notPEM := filepath.Join(dir, "not.pem")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	wrongBlock := filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(wrongBlock, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	badDER := filepath.Join(dir, "bad.pem")
+	if err := os.WriteFile(badDER, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{notPEM, wrongBlock, badDER} {
+		if _, err := loadWebhookCertificate(path, "https://bot.example.com/hook"); !errors.Is(err, ErrInvalidCertificate) {
+			t.Fatalf("%s: got error %v, want %v", filepath.Base(path), err, ErrInvalidCertificate)
+		}
+	}
+}